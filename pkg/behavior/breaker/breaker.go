@@ -21,6 +21,8 @@ import (
 var (
 	// ErrCircuitOpen is returned when the state of Circuit Breaker is open.
 	ErrCircuitOpen = errors.New("circuit breaker is open")
+	// ErrBulkheadFull is returned when maximum number of concurrent executions in Circuit Breaker is reached.
+	ErrBulkheadFull = errors.New("circuit breaker bulkhead is full")
 )
 
 // Action function that will be executed inside CircuitBreaker.
@@ -31,10 +33,19 @@ type Action func() (any, error)
 // MaxFailuresThreshold Maximum number of failures allowed.
 //
 // ResetTimeout in seconds, is the period of the open state. After which the state of the CircuitBreaker becomes half-open.
+//
+// MaxResetTimeout in seconds, optional, is the cap of the open state period. When probe in half-open state fails,
+// the period is doubled for each consecutive failed probe until it reaches MaxResetTimeout.
+// If not set, then period of the open state is always ResetTimeout.
+//
+// MaxConcurrentCalls optional, is the maximum number of Action executed at the same time (bulkhead),
+// excess calls are rejected with ErrBulkheadFull. If not set or "0", then concurrency is not limited.
 // fields can be used for example as ENV variables in your project like MY_APP_CB_MAX_FAILURES_THRESHOLD:"3".
 type Configuration struct {
 	MaxFailuresThreshold string `json:"cb_max_failures_threshold,omitempty"`
 	ResetTimeout         string `json:"cb_reset_timeout,omitempty"`
+	MaxResetTimeout      string `json:"cb_max_reset_timeout,omitempty"`
+	MaxConcurrentCalls   string `json:"cb_max_concurrent_calls,omitempty"`
 }
 
 // CircuitBreakerHandler is the interface for circuit breaker functionality.
@@ -55,10 +66,14 @@ type CircuitBreaker struct {
 	mu           sync.RWMutex
 	currentState State
 
-	timeout      time.Duration // Duration when state must be closed
-	lastAttempt  time.Time     // Timestamp of the last attempt to execution
-	failureCount uint64        // Current count of consecutive failures
-	failureLimit uint64        // Number of failures that will switch the state from closed to open
+	timeout          time.Duration // Duration when state must be closed
+	maxTimeout       time.Duration // Cap of timeout when it grows on consecutive failed probes
+	lastAttempt      time.Time     // Timestamp of the last attempt to execution
+	failureCount     uint64        // Current count of consecutive failures
+	failureLimit     uint64        // Number of failures that will switch the state from closed to open
+	consecutiveTrips uint64        // Current count of consecutive failed probes in half-open state
+
+	bulkhead chan struct{} // Semaphore limiting concurrent executions of Action, nil if not limited
 }
 
 // NewCircuitBreaker creates a new CircuitBreaker instance with the specified configuration.
@@ -75,13 +90,35 @@ func NewCircuitBreaker(cfg *Configuration) (*CircuitBreaker, error) {
 		return nil, errors.Join(fmt.Errorf(errTmpl, "MaxFailuresThreshold in CircuitBreaker"), maxFailuresThresholdErr)
 	}
 
+	maxResetTimeout := restTimout
+	if cfg.MaxResetTimeout != "" {
+		var maxResetTimeoutErr error
+		maxResetTimeout, maxResetTimeoutErr = stringconv.ToWholeNumber[int32](cfg.MaxResetTimeout)
+		if maxResetTimeoutErr != nil {
+			return nil, errors.Join(fmt.Errorf(errTmpl, "MaxResetTimeout in CircuitBreaker"), maxResetTimeoutErr)
+		}
+	}
+
+	var maxConcurrentCalls uint32
+	if cfg.MaxConcurrentCalls != "" {
+		var maxConcurrentCallsErr error
+		maxConcurrentCalls, maxConcurrentCallsErr = stringconv.ToWholeNumber[uint32](cfg.MaxConcurrentCalls)
+		if maxConcurrentCallsErr != nil {
+			return nil, errors.Join(fmt.Errorf(errTmpl, "MaxConcurrentCalls in CircuitBreaker"), maxConcurrentCallsErr)
+		}
+	}
+
 	cb := &CircuitBreaker{
 		currentState: StateClosed,
 		timeout:      time.Second * time.Duration(restTimout),
+		maxTimeout:   time.Second * time.Duration(maxResetTimeout),
 		OnSuccess:    func() {},
 		OnFailure:    func() {},
 		failureLimit: maxFailuresThreshold,
 	}
+	if maxConcurrentCalls > 0 {
+		cb.bulkhead = make(chan struct{}, maxConcurrentCalls)
+	}
 
 	return cb, nil
 }
@@ -97,6 +134,11 @@ func (cb *CircuitBreaker) Proceed(action Action) (any, error) {
 			return nil, ErrCircuitOpen
 		}
 	case StateHalfOpen, StateClosed:
+		if !cb.acquireBulkhead() {
+			return nil, ErrBulkheadFull
+		}
+		defer cb.releaseBulkhead()
+
 		result, err := action()
 		if err != nil {
 			cb.recordFailure()
@@ -119,10 +161,25 @@ func (cb *CircuitBreaker) GetState() State {
 	return cb.currentState
 }
 
+// RetryAfter returns how long until CircuitBreaker becomes half-open and may allow execution again,
+// it can be used as backoff hint for the caller, for example as Retry-After header when ErrCircuitOpen is returned.
+// Zero is returned if state is not open or the open period already passed.
+func (cb *CircuitBreaker) RetryAfter() time.Duration {
+	cb.mu.RLock()
+	defer cb.mu.RUnlock()
+
+	if cb.currentState != StateOpen {
+		return 0
+	}
+
+	return max(cb.currentTimeout()-time.Since(cb.lastAttempt), 0)
+}
+
 // Reset or reboot CircuitBreaker state to initial.
 func (cb *CircuitBreaker) Reset() {
 	cb.mu.Lock()
 	cb.failureCount = 0
+	cb.consecutiveTrips = 0
 	cb.mu.Unlock()
 
 	cb.setState(StateClosed)
@@ -137,18 +194,24 @@ func (cb *CircuitBreaker) setState(state State) {
 
 func (cb *CircuitBreaker) recordFailure() {
 	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
 	cb.failureCount++
 	cb.lastAttempt = time.Now()
-	isExceededFailureCount := cb.failureCount > cb.failureLimit
-	cb.mu.Unlock()
+	if cb.failureCount <= cb.failureLimit {
+		return
+	}
 
-	if isExceededFailureCount {
-		cb.setState(StateOpen)
+	// State is switched in the same critical section, so concurrent failed probes count as one trip.
+	if cb.currentState == StateHalfOpen {
+		cb.consecutiveTrips++
 	}
+	cb.currentState = StateOpen
 }
 
 func (cb *CircuitBreaker) recordSuccess() {
 	cb.mu.Lock()
+	cb.consecutiveTrips = 0
 
 	if cb.failureCount > 0 {
 		cb.failureCount--
@@ -159,9 +222,45 @@ func (cb *CircuitBreaker) recordSuccess() {
 	}
 }
 
+// acquireBulkhead takes place for Action execution, returns false if all places are taken.
+func (cb *CircuitBreaker) acquireBulkhead() bool {
+	if cb.bulkhead == nil {
+		return true
+	}
+
+	select {
+	case cb.bulkhead <- struct{}{}:
+		return true
+	default:
+		return false
+	}
+}
+
+// releaseBulkhead frees place taken by acquireBulkhead.
+func (cb *CircuitBreaker) releaseBulkhead() {
+	if cb.bulkhead != nil {
+		<-cb.bulkhead
+	}
+}
+
 func (cb *CircuitBreaker) isTimeout() bool {
 	cb.mu.RLock()
 	defer cb.mu.RUnlock()
 
-	return time.Since(cb.lastAttempt) > cb.timeout
+	return time.Since(cb.lastAttempt) > cb.currentTimeout()
+}
+
+// currentTimeout of the open state, doubled for each consecutive failed probe and capped by maxTimeout.
+// Must be called under the lock.
+func (cb *CircuitBreaker) currentTimeout() time.Duration {
+	timeout := cb.timeout
+	for i := uint64(0); i < cb.consecutiveTrips && timeout < cb.maxTimeout; i++ {
+		timeout *= 2
+	}
+
+	if cb.maxTimeout > cb.timeout && timeout > cb.maxTimeout {
+		return cb.maxTimeout
+	}
+
+	return timeout
 }
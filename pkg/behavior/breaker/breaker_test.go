@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"sync"
 	"testing"
 	"time"
 
@@ -192,6 +193,156 @@ func TestResetCircuitBreakerState(t *testing.T) {
 	assert.True(t, isSuccessAfterReset, "Expected to be true after CircuitBreaker.Reset() and CircuitBreaker.Proceed(action)")
 }
 
+func TestProbeIntervalBackoffOnRepeatedFailures(t *testing.T) {
+	cfg := &Configuration{MaxFailuresThreshold: "0", ResetTimeout: "1", MaxResetTimeout: "8"}
+
+	cb, cbErr := NewCircuitBreaker(cfg)
+	assert.Nil(t, cbErr)
+	assert.Equal(t, 8*time.Second, cb.maxTimeout)
+
+	cb.timeout = 5 * time.Millisecond
+	cb.maxTimeout = 20 * time.Millisecond
+
+	actionWithErr := func() (any, error) {
+		return nil, errors.New("failed")
+	}
+
+	_, _ = cb.Proceed(actionWithErr)
+	assert.True(t, cb.GetState().IsState(StateOpen))
+
+	expectedTimeouts := []time.Duration{
+		5 * time.Millisecond,
+		10 * time.Millisecond,
+		20 * time.Millisecond,
+		20 * time.Millisecond, // Capped by maxTimeout.
+	}
+	for i, expectedTimeout := range expectedTimeouts {
+		cb.mu.RLock()
+		currentTimeout := cb.currentTimeout()
+		cb.mu.RUnlock()
+		assert.Equal(t, expectedTimeout, currentTimeout, "unexpected probe interval after %d failed probes", i)
+
+		_, rErr := cb.Proceed(actionWithErr)
+		assert.ErrorIs(t, rErr, ErrCircuitOpen, "expected that probe is not allowed before interval passed")
+
+		time.Sleep(currentTimeout + time.Millisecond)
+
+		_, rErr = cb.Proceed(actionWithErr)
+		assert.NotErrorIs(t, rErr, ErrCircuitOpen, "expected that probe is allowed after interval passed")
+		assert.True(t, cb.GetState().IsState(StateOpen))
+	}
+
+	time.Sleep(cb.maxTimeout + time.Millisecond)
+
+	_, rErr := cb.Proceed(func() (any, error) { return nil, nil })
+	assert.Nil(t, rErr)
+
+	cb.mu.RLock()
+	defer cb.mu.RUnlock()
+	assert.Equal(t, cb.timeout, cb.currentTimeout(), "expected that probe interval is reset after successful probe")
+}
+
+func TestConcurrentFailedProbesCountAsOneTrip(t *testing.T) {
+	cb, cbErr := NewCircuitBreaker(&Configuration{MaxFailuresThreshold: "0", ResetTimeout: "1", MaxResetTimeout: "60"})
+	assert.Nil(t, cbErr)
+
+	cb.setState(StateHalfOpen)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			cb.recordFailure()
+		}()
+	}
+	wg.Wait()
+
+	assert.True(t, cb.GetState().IsState(StateOpen))
+	cb.mu.RLock()
+	defer cb.mu.RUnlock()
+	assert.Equal(t, uint64(1), cb.consecutiveTrips, "expected that backoff is doubled once per open cycle")
+}
+
+func TestNewCircuitBreakerWithInvalidMaxResetTimeout(t *testing.T) {
+	cb, err := NewCircuitBreaker(&Configuration{MaxFailuresThreshold: "3", ResetTimeout: "5", MaxResetTimeout: "five"})
+	assert.Error(t, err)
+	assert.Nil(t, cb)
+}
+
+func TestBulkheadLimitsConcurrentCalls(t *testing.T) {
+	cfg := &Configuration{MaxFailuresThreshold: "3", ResetTimeout: "1", MaxConcurrentCalls: "2"}
+
+	cb, cbErr := NewCircuitBreaker(cfg)
+	assert.Nil(t, cbErr)
+
+	const calls = 5
+	release := make(chan struct{})
+	var inFlight sync.WaitGroup
+	inFlight.Add(2)
+
+	results := make(chan error, calls)
+	for i := 0; i < calls; i++ {
+		go func() {
+			_, err := cb.Proceed(func() (any, error) {
+				inFlight.Done()
+				<-release
+				return nil, nil
+			})
+			results <- err
+		}()
+	}
+
+	// Wait until limit of calls is executing, then all others must be rejected.
+	inFlight.Wait()
+
+	rejected := 0
+	for i := 0; i < calls-2; i++ {
+		err := <-results
+		assert.ErrorIs(t, err, ErrBulkheadFull)
+		rejected++
+	}
+	assert.Equal(t, calls-2, rejected)
+
+	close(release)
+	for i := 0; i < 2; i++ {
+		assert.NoError(t, <-results)
+	}
+
+	assert.True(t, cb.GetState().IsState(StateClosed), "expected that rejected calls are not counted as failures")
+
+	_, err := cb.Proceed(func() (any, error) { return nil, nil })
+	assert.NoError(t, err, "expected that places are released after execution")
+}
+
+func TestNewCircuitBreakerWithInvalidMaxConcurrentCalls(t *testing.T) {
+	cb, err := NewCircuitBreaker(&Configuration{MaxFailuresThreshold: "3", ResetTimeout: "5", MaxConcurrentCalls: "many"})
+	assert.Error(t, err)
+	assert.Nil(t, cb)
+}
+
+func TestRetryAfter(t *testing.T) {
+	cb, cbErr := NewCircuitBreaker(&Configuration{MaxFailuresThreshold: "0", ResetTimeout: "1"})
+	assert.Nil(t, cbErr)
+	cb.timeout = 50 * time.Millisecond
+	cb.maxTimeout = cb.timeout
+
+	assert.Equal(t, time.Duration(0), cb.RetryAfter(), "expected no backoff when state is closed")
+
+	_, _ = cb.Proceed(func() (any, error) { return nil, errors.New("failed") })
+	assert.True(t, cb.GetState().IsState(StateOpen))
+
+	firstRetryAfter := cb.RetryAfter()
+	assert.True(t, firstRetryAfter > 0 && firstRetryAfter <= cb.timeout, "unexpected retry after: %v", firstRetryAfter)
+
+	time.Sleep(10 * time.Millisecond)
+	secondRetryAfter := cb.RetryAfter()
+	assert.True(t, secondRetryAfter < firstRetryAfter, "expected that retry after shrinks, given %v then %v", firstRetryAfter, secondRetryAfter)
+
+	time.Sleep(cb.timeout)
+	assert.Equal(t, time.Duration(0), cb.RetryAfter(), "expected zero once open period elapsed")
+}
+
 func Example_wrappingExecutionInCircuitBreaker() {
 	cbCfg := &Configuration{
 		MaxFailuresThreshold: "100", // Amount of allowed failures before state will be Open.
@@ -0,0 +1,79 @@
+package breaker
+
+import (
+	"errors"
+	"net/http"
+)
+
+// errServerResponse used internally to mark HTTP 5xx response as failure of Action.
+var errServerResponse = errors.New("circuit breaker - server error response")
+
+type (
+	// TransportOptions sets of configurations for http.RoundTripper created by CircuitBreaker.RoundTripper.
+	TransportOptions func(t *transport)
+
+	// transport is http.RoundTripper that executes each request inside CircuitBreaker.
+	transport struct {
+		base                http.RoundTripper
+		breaker             CircuitBreakerHandler
+		isTripOnServerError bool
+	}
+)
+
+// TripOnServerError defines if HTTP 5xx responses must be counted as failures of CircuitBreaker, enabled by default.
+func TripOnServerError(isEnabled bool) TransportOptions {
+	return func(t *transport) { t.isTripOnServerError = isEnabled }
+}
+
+// RoundTripper wraps base http.RoundTripper so each request is executed through CircuitBreaker.Proceed.
+// Transport errors and HTTP 5xx responses (see TripOnServerError) are counted as failures,
+// when CircuitBreaker is open request is not sent and ErrCircuitOpen is returned.
+// If base is nil then http.DefaultTransport is used.
+func (cb *CircuitBreaker) RoundTripper(base http.RoundTripper, opts ...TransportOptions) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	t := &transport{base: base, breaker: cb, isTripOnServerError: true}
+	for _, o := range opts {
+		o(t)
+	}
+
+	return t
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var resp *http.Response
+	isSent := false
+
+	_, err := t.breaker.Proceed(func() (any, error) {
+		isSent = true
+		var rtErr error
+		resp, rtErr = t.base.RoundTrip(req) //nolint:bodyclose // response is returned to the caller who owns the body.
+		if rtErr != nil {
+			return nil, rtErr
+		}
+
+		if t.isTripOnServerError && resp.StatusCode >= http.StatusInternalServerError {
+			return nil, errServerResponse
+		}
+
+		return resp, nil
+	})
+
+	// NOTE: Server error response is still valid HTTP response for the caller, only CircuitBreaker must know about failure.
+	if errors.Is(err, errServerResponse) {
+		return resp, nil
+	}
+	if err != nil {
+		// NOTE: RoundTripper must always close request body, base did it only if request was sent.
+		if !isSent && req.Body != nil {
+			_ = req.Body.Close()
+		}
+
+		return nil, err
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,125 @@
+package breaker
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// stubTrackingBody records if request body was closed.
+type stubTrackingBody struct {
+	io.Reader
+	isClosed bool
+}
+
+func (b *stubTrackingBody) Close() error {
+	b.isClosed = true
+	return nil
+}
+
+func TestRoundTripperOpensOnServerErrors(t *testing.T) {
+	var serverHits atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		serverHits.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	cb, cbErr := NewCircuitBreaker(&Configuration{MaxFailuresThreshold: "1", ResetTimeout: "10"})
+	assert.Nil(t, cbErr)
+
+	client := &http.Client{Transport: cb.RoundTripper(server.Client().Transport)}
+
+	for i := 0; i < 2; i++ {
+		resp, err := client.Get(server.URL)
+		assert.NoError(t, err, "expected server error response to be returned to the caller")
+		assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+		_ = resp.Body.Close()
+	}
+
+	assert.True(t, cb.GetState().IsState(StateOpen), "expected to have state: %v but given: %v", StateOpen, cb.GetState())
+
+	resp, err := client.Get(server.URL)
+	if resp != nil {
+		_ = resp.Body.Close()
+	}
+	assert.ErrorIs(t, err, ErrCircuitOpen)
+	assert.Equal(t, int32(2), serverHits.Load(), "expected that request is not sent when circuit is open")
+}
+
+func TestRoundTripperNotTrippingOnServerErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	cb, cbErr := NewCircuitBreaker(&Configuration{MaxFailuresThreshold: "1", ResetTimeout: "10"})
+	assert.Nil(t, cbErr)
+
+	client := &http.Client{Transport: cb.RoundTripper(server.Client().Transport, TripOnServerError(false))}
+
+	for i := 0; i < 3; i++ {
+		resp, err := client.Get(server.URL)
+		assert.NoError(t, err)
+		_ = resp.Body.Close()
+	}
+
+	assert.True(t, cb.GetState().IsState(StateClosed), "expected to have state: %v but given: %v", StateClosed, cb.GetState())
+}
+
+func TestRoundTripperOpensOnTransportErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	serverURL := server.URL
+	server.Close()
+
+	cb, cbErr := NewCircuitBreaker(&Configuration{MaxFailuresThreshold: "0", ResetTimeout: "10"})
+	assert.Nil(t, cbErr)
+
+	client := &http.Client{Transport: cb.RoundTripper(nil)}
+
+	resp, err := client.Get(serverURL)
+	if resp != nil {
+		_ = resp.Body.Close()
+	}
+	assert.Error(t, err)
+	assert.NotErrorIs(t, err, ErrCircuitOpen)
+
+	resp, err = client.Get(serverURL)
+	if resp != nil {
+		_ = resp.Body.Close()
+	}
+	assert.ErrorIs(t, err, ErrCircuitOpen)
+}
+
+func TestRoundTripperClosesBodyWhenCircuitOpen(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	cb, cbErr := NewCircuitBreaker(&Configuration{MaxFailuresThreshold: "0", ResetTimeout: "10"})
+	assert.Nil(t, cbErr)
+
+	rt := cb.RoundTripper(server.Client().Transport)
+
+	body := &stubTrackingBody{Reader: strings.NewReader("unit")}
+	req, reqErr := http.NewRequest(http.MethodPost, server.URL, body)
+	assert.NoError(t, reqErr)
+	resp, err := rt.RoundTrip(req)
+	assert.NoError(t, err)
+	_ = resp.Body.Close()
+	assert.True(t, cb.GetState().IsState(StateOpen), "expected to have state: %v but given: %v", StateOpen, cb.GetState())
+
+	body = &stubTrackingBody{Reader: strings.NewReader("unit")}
+	req, reqErr = http.NewRequest(http.MethodPost, server.URL, body)
+	assert.NoError(t, reqErr)
+	resp, err = rt.RoundTrip(req)
+	assert.Nil(t, resp)
+	assert.ErrorIs(t, err, ErrCircuitOpen)
+	assert.True(t, body.isClosed, "expected that request body is closed when request is not sent")
+}
@@ -4,39 +4,103 @@ package contextext
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"reflect"
 	"sync"
 	"time"
 )
 
+// ErrMergeConflict returned by ContextExtended.Merge when MergeErrorOnConflict policy used and both contexts have same key.
+var ErrMergeConflict = errors.New("contextext - merge conflict")
+
 type (
+	// MergePolicy defines how ContextExtended.Merge resolves keys existing in both contexts.
+	MergePolicy byte
+
+	// TypedKey is implemented by Key, it allows ContextExtended to accept Key of any scope.
+	TypedKey interface {
+		typedKey()
+	}
+
+	// Key of value in ContextExtended that avoids collisions of string keys between unrelated code.
+	// Keys are equal only if both scope K and name are equal, so use unexported type as scope,
+	// like `type myPackageScope struct{}`, same as idiomatic unexported key types for context.WithValue.
+	Key[K any] struct {
+		name string
+	}
+
 	contextExtendedKey string
 	// ContextExtended enhances the standard context with additional features.
 	ContextExtended[T any] struct {
 		values   sync.Map
 		ctx      context.Context
-		cancel   context.CancelFunc
+		cancel   context.CancelCauseFunc
 		deadline time.Time
 	}
 )
 
+// These constants related to MergePolicy of ContextExtended.Merge.
+const (
+	// MergeLastWins overwrites existing values with values from the other context.
+	MergeLastWins MergePolicy = iota
+	// MergeErrorOnConflict fails without changes if any key exists in both contexts.
+	MergeErrorOnConflict
+)
+
 // NewContextExtended constructor for ContextExtended with a given original context.
 func NewContextExtended[T any](base context.Context) *ContextExtended[T] {
-	ctx, cancel := context.WithCancel(base)
+	ctx, cancel := context.WithCancelCause(base)
 	ctxExt := &ContextExtended[T]{cancel: cancel}
 	ctxExt.ctx = storeContextExtended(ctx, ctxExt)
 
 	return ctxExt
 }
 
+// NewKey creates Key with given name in scope of type K.
+func NewKey[K any](name string) Key[K] {
+	return Key[K]{name: name}
+}
+
+// String implements stringer interface.
+func (k Key[K]) String() string {
+	return fmt.Sprintf("%v(%s)", reflect.TypeOf((*K)(nil)).Elem(), k.name)
+}
+
+func (k Key[K]) typedKey() {}
+
 // ExtendTimout extends the context's timeout.
 func (ce *ContextExtended[T]) ExtendTimout(d time.Duration) {
 	newDeadline := time.Now().Add(d)
 	if ce.deadline.IsZero() || newDeadline.After(ce.deadline) {
-		ce.ctx, ce.cancel = context.WithDeadline(ce.ctx, newDeadline)
-		ce.deadline = newDeadline
+		ce.withDeadline(newDeadline)
+	}
+}
+
+// ShortenTimeout tightens the context's timeout, new deadline is installed only if it's earlier than the current one.
+// If the current deadline already passed then context is done and nothing changes,
+// non-positive duration makes context done immediately.
+func (ce *ContextExtended[T]) ShortenTimeout(d time.Duration) {
+	newDeadline := time.Now().Add(d)
+	if currentDeadline, ok := ce.ctx.Deadline(); ok && !newDeadline.Before(currentDeadline) {
+		return
+	}
+
+	ce.withDeadline(newDeadline)
+}
+
+// withDeadline derives context with new deadline from the current one.
+func (ce *ContextExtended[T]) withDeadline(newDeadline time.Time) {
+	ctx, cancel := context.WithDeadline(ce.ctx, newDeadline)
+	previousCancel := ce.cancel
+	ce.ctx = ctx
+	// NOTE: Previous context is parent of new one, so it must be canceled only together with it to release resources.
+	// Parent is canceled first, so cause propagates to the new context.
+	ce.cancel = func(cause error) {
+		previousCancel(cause)
+		cancel()
 	}
+	ce.deadline = newDeadline
 }
 
 // AddValue safely adds a value to the context.
@@ -55,11 +119,52 @@ func (ce *ContextExtended[T]) GetValue(key any) (T, bool) {
 	return zero, false
 }
 
+// AddValueK safely adds a value to the context by typed key, see Key.
+func (ce *ContextExtended[T]) AddValueK(key TypedKey, value T) {
+	ce.AddValue(key, value)
+}
+
+// GetValueK safely retrieves a value from the context by typed key, see Key.
+func (ce *ContextExtended[T]) GetValueK(key TypedKey) (T, bool) {
+	return ce.GetValue(key)
+}
+
 // RemoveValue safely removes a value from the context.
 func (ce *ContextExtended[T]) RemoveValue(key any) {
 	ce.values.Delete(key)
 }
 
+// Merge copies values of the other ContextExtended into this one, keys existing in both contexts are resolved by MergePolicy.
+// Each value is read and stored thread safely, but Merge is not atomic against concurrent AddValue on the same keys.
+func (ce *ContextExtended[T]) Merge(other *ContextExtended[T], policy MergePolicy) error {
+	if other == nil || other == ce {
+		return nil
+	}
+
+	if policy == MergeErrorOnConflict {
+		var conflictErr error
+		other.values.Range(func(key, _ any) bool {
+			if _, exist := ce.values.Load(key); exist {
+				conflictErr = fmt.Errorf("%w: key %v exists in both contexts", ErrMergeConflict, key)
+				return false
+			}
+
+			return true
+		})
+
+		if conflictErr != nil {
+			return conflictErr
+		}
+	}
+
+	other.values.Range(func(key, value any) bool {
+		ce.values.Store(key, value)
+		return true
+	})
+
+	return nil
+}
+
 // Deadline returns the time when work done on behalf of this context should be canceled.
 func (ce *ContextExtended[T]) Deadline() (deadline time.Time, ok bool) {
 	return ce.ctx.Deadline()
@@ -91,9 +196,22 @@ func (ce *ContextExtended[T]) Value(key any) any {
 	return nil
 }
 
-// Cancel cancels the context.
+// Cancel cancels the context, its Cause is context.Canceled.
 func (ce *ContextExtended[T]) Cancel() {
-	ce.cancel()
+	ce.cancel(nil)
+}
+
+// CancelCause cancels the context with cause, so downstream code can learn why it was canceled with Cause.
+// Err still returns context.Canceled, nil cause is the same as Cancel.
+func (ce *ContextExtended[T]) CancelCause(cause error) {
+	ce.cancel(cause)
+}
+
+// Cause returns why the context was canceled, see context.Cause.
+// It's the error given to CancelCause, context.DeadlineExceeded if deadline passed
+// or cause of the parent context, nil if context is not canceled yet.
+func (ce *ContextExtended[T]) Cause() error {
+	return context.Cause(ce.ctx)
 }
 
 // SafelyExtractExtendedContextFromInterface by casting interface to extended generic context.
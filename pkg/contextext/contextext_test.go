@@ -2,8 +2,10 @@ package contextext
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"reflect"
+	"sync"
 	"testing"
 	"time"
 
@@ -12,6 +14,11 @@ import (
 
 type unitStubContextKey struct{}
 
+type (
+	unitStubFirstModuleScope  struct{}
+	unitStubSecondModuleScope struct{}
+)
+
 func TestContextExtended(t *testing.T) {
 	baseCtx := context.Background()
 	cp := NewContextExtended[string](baseCtx)
@@ -52,6 +59,197 @@ func TestContextExtended(t *testing.T) {
 	}
 }
 
+func TestShortenTimeout(t *testing.T) {
+	cp := NewContextExtended[string](context.Background())
+	cp.AddValue("key1", "value1")
+
+	cp.ExtendTimout(time.Hour)
+	extendedDeadline, ok := cp.Deadline()
+	assert.True(t, ok)
+
+	// Later deadline must not replace earlier one.
+	cp.ShortenTimeout(2 * time.Hour)
+	notChangedDeadline, _ := cp.Deadline()
+	assert.Equal(t, extendedDeadline, notChangedDeadline)
+
+	startedAt := time.Now()
+	cp.ShortenTimeout(10 * time.Millisecond)
+	shortenedDeadline, _ := cp.Deadline()
+	assert.True(t, shortenedDeadline.Before(extendedDeadline))
+
+	select {
+	case <-cp.Done():
+		assert.True(t, time.Since(startedAt) < time.Second, "expected context to be done sooner")
+		assert.ErrorIs(t, cp.Err(), context.DeadlineExceeded)
+	case <-time.After(time.Second):
+		t.Errorf("expected context to be done after shortened timeout")
+	}
+
+	value, ok := cp.GetValue("key1")
+	assert.True(t, ok)
+	assert.Equal(t, "value1", value)
+
+	result, err := SafelyExtractExtendedContextFromInterface[string](cp)
+	assert.NoError(t, err)
+	assert.Same(t, cp, result)
+
+	cp.Cancel()
+}
+
+func TestShortenTimeoutWithNonPositiveDuration(t *testing.T) {
+	cp := NewContextExtended[string](context.Background())
+	defer cp.Cancel()
+
+	cp.ShortenTimeout(0)
+
+	select {
+	case <-cp.Done():
+	case <-time.After(time.Second):
+		t.Errorf("expected context to be done immediately")
+	}
+}
+
+func TestCancelCause(t *testing.T) {
+	cp := NewContextExtended[string](context.Background())
+	cp.ExtendTimout(time.Hour)
+	assert.NoError(t, cp.Cause(), "expected no cause before cancellation")
+
+	dependencyErr := errors.New("database is unavailable")
+	cp.CancelCause(dependencyErr)
+
+	select {
+	case <-cp.Done():
+		assert.ErrorIs(t, cp.Err(), context.Canceled)
+		assert.ErrorIs(t, cp.Cause(), dependencyErr, "expected that cause propagates through derived deadline context")
+		assert.ErrorIs(t, context.Cause(cp), dependencyErr)
+	case <-time.After(time.Second):
+		t.Errorf("expected context to be done after cancellation")
+	}
+
+	cp.CancelCause(errors.New("late cause"))
+	assert.ErrorIs(t, cp.Cause(), dependencyErr, "expected that the first cause is kept")
+
+	canceled := NewContextExtended[string](context.Background())
+	canceled.Cancel()
+	assert.ErrorIs(t, canceled.Cause(), context.Canceled)
+
+	expired := NewContextExtended[string](context.Background())
+	defer expired.Cancel()
+	expired.ShortenTimeout(0)
+	<-expired.Done()
+	assert.ErrorIs(t, expired.Cause(), context.DeadlineExceeded)
+}
+
+func TestMerge(t *testing.T) {
+	t.Run("LastWins", func(t *testing.T) {
+		extCtx := NewContextExtended[string](context.Background())
+		extCtx.AddValue("shared", "first")
+		extCtx.AddValue("first", "1")
+
+		otherExtCtx := NewContextExtended[string](context.Background())
+		otherExtCtx.AddValue("shared", "second")
+		otherExtCtx.AddValue(unitStubContextKey{}, "2")
+
+		assert.NoError(t, extCtx.Merge(otherExtCtx, MergeLastWins))
+
+		value, _ := extCtx.GetValue("shared")
+		assert.Equal(t, "second", value)
+		value, _ = extCtx.GetValue("first")
+		assert.Equal(t, "1", value)
+		value, _ = extCtx.GetValue(unitStubContextKey{})
+		assert.Equal(t, "2", value)
+
+		_, exist := otherExtCtx.GetValue("first")
+		assert.False(t, exist, "expected that other context is not changed")
+	})
+
+	t.Run("ErrorOnConflict", func(t *testing.T) {
+		extCtx := NewContextExtended[string](context.Background())
+		extCtx.AddValue("shared", "first")
+
+		otherExtCtx := NewContextExtended[string](context.Background())
+		otherExtCtx.AddValue("shared", "second")
+		otherExtCtx.AddValue("other", "2")
+
+		err := extCtx.Merge(otherExtCtx, MergeErrorOnConflict)
+		assert.ErrorIs(t, err, ErrMergeConflict)
+
+		value, _ := extCtx.GetValue("shared")
+		assert.Equal(t, "first", value)
+		_, exist := extCtx.GetValue("other")
+		assert.False(t, exist, "expected that nothing is merged on conflict")
+
+		otherExtCtx.RemoveValue("shared")
+		assert.NoError(t, extCtx.Merge(otherExtCtx, MergeErrorOnConflict))
+		value, _ = extCtx.GetValue("other")
+		assert.Equal(t, "2", value)
+	})
+
+	t.Run("Concurrent", func(t *testing.T) {
+		extCtx := NewContextExtended[int](context.Background())
+		otherExtCtx := NewContextExtended[int](context.Background())
+
+		var wg sync.WaitGroup
+		for i := 0; i < 10; i++ {
+			wg.Add(2)
+			go func(i int) {
+				defer wg.Done()
+				otherExtCtx.AddValue(i, i)
+			}(i)
+			go func() {
+				defer wg.Done()
+				assert.NoError(t, extCtx.Merge(otherExtCtx, MergeLastWins))
+			}()
+		}
+		wg.Wait()
+
+		assert.NoError(t, extCtx.Merge(otherExtCtx, MergeLastWins))
+		for i := 0; i < 10; i++ {
+			value, exist := extCtx.GetValue(i)
+			assert.True(t, exist)
+			assert.Equal(t, i, value)
+		}
+	})
+}
+
+func TestTypedKey(t *testing.T) {
+	extCtx := NewContextExtended[string](context.Background())
+
+	// Same string keys from unrelated code collide.
+	extCtx.AddValue("user", "first module user")
+	extCtx.AddValue("user", "second module user")
+	value, _ := extCtx.GetValue("user")
+	assert.Equal(t, "second module user", value)
+
+	firstModuleKey := NewKey[unitStubFirstModuleScope]("user")
+	secondModuleKey := NewKey[unitStubSecondModuleScope]("user")
+
+	extCtx.AddValueK(firstModuleKey, "first module user")
+	extCtx.AddValueK(secondModuleKey, "second module user")
+
+	value, ok := extCtx.GetValueK(firstModuleKey)
+	assert.True(t, ok)
+	assert.Equal(t, "first module user", value)
+
+	value, ok = extCtx.GetValueK(secondModuleKey)
+	assert.True(t, ok)
+	assert.Equal(t, "second module user", value)
+
+	value, ok = extCtx.GetValueK(NewKey[unitStubFirstModuleScope]("user"))
+	assert.True(t, ok, "expected that key with same scope and name is equal")
+	assert.Equal(t, "first module user", value)
+
+	_, ok = extCtx.GetValueK(NewKey[unitStubFirstModuleScope]("account"))
+	assert.False(t, ok)
+
+	assert.Equal(t, "first module user", extCtx.Value(firstModuleKey), "expected that typed key works with vanilla context")
+	assert.Equal(t, "contextext.unitStubFirstModuleScope(user)", firstModuleKey.String())
+
+	extCtx.RemoveValue(firstModuleKey)
+	_, ok = extCtx.GetValueK(firstModuleKey)
+	assert.False(t, ok)
+}
+
 func TestSafelyExtractExtendedContextFromInterface(t *testing.T) {
 	t.Run("CorrectType", func(t *testing.T) {
 		expectedValue := "test value"
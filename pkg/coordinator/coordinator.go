@@ -29,6 +29,13 @@ import (
 	"golang.org/x/sync/errgroup"
 )
 
+const (
+	// ProcessPhaseStart is phase given to OnProcessTiming hook when OnStart of process returned.
+	ProcessPhaseStart = "start"
+	// ProcessPhaseStop is phase given to OnProcessTiming hook when OnStop of process returned.
+	ProcessPhaseStop = "stop"
+)
+
 type (
 	// ServiceCoordinator manages the lifecycle of an application.
 	// Responsible for overseeing the processes and tasks that constitute the app,
@@ -56,6 +63,11 @@ type (
 		// this function will cancel the mainContext and begin the shutdown process for
 		// ServiceCoordinator and its managed tasks.
 		mainContextCancel func()
+		// startContextValues are shared dependencies that are added to the context
+		// given to each process on start, like logger or configuration.
+		startContextValues map[any]any
+		// onProcessTiming is called with duration of each process OnStart and OnStop.
+		onProcessTiming func(name, phase string, d time.Duration)
 	}
 
 	// Options sets of configurations for ServiceCoordinator.
@@ -73,12 +85,37 @@ func SetForceStopTimeout(t time.Duration) Options {
 }
 
 // AddProcesses that will be executed in background of main loop.
+// Processes that implement process.Dependent are gracefully stopped before their dependencies,
+// OnStart of all processes is called concurrently, so start order is not guaranteed.
 func AddProcesses(p ...process.Process) Options {
 	return func(c *ServiceCoordinator) {
 		c.processes = append(c.processes, p...)
 	}
 }
 
+// WithStartContextValues adds values to context that each process receives in OnStart,
+// so shared dependencies could be passed without global singletons.
+// Keys are used same as in context.WithValue, so they must not be nil and should be of own type.
+func WithStartContextValues(values map[any]any) Options {
+	return func(c *ServiceCoordinator) {
+		if c.startContextValues == nil {
+			c.startContextValues = make(map[any]any, len(values))
+		}
+
+		for k, v := range values {
+			c.startContextValues[k] = v
+		}
+	}
+}
+
+// OnProcessTiming sets hook that receives how long OnStart and OnStop of each process took,
+// for example to send it as metrics. Phase is ProcessPhaseStart or ProcessPhaseStop.
+// Note that for processes that block in OnStart until stopped, like servers, start duration is their run time.
+// Hook is called concurrently from goroutines of processes.
+func OnProcessTiming(hook func(name, phase string, d time.Duration)) Options {
+	return func(c *ServiceCoordinator) { c.onProcessTiming = hook }
+}
+
 // NewServiceCoordinator instance to manage the application.
 func NewServiceCoordinator(opts ...Options) (b *ServiceCoordinator) {
 	b = &ServiceCoordinator{
@@ -102,17 +139,40 @@ func (c *ServiceCoordinator) Start() error {
 	var bgTasksWG sync.WaitGroup
 	// This channel listens for OS-level interrupt signals.
 	interruptSignal := make(chan os.Signal, 1)
+	// Resolve order of goroutines / processes by declared dependencies.
+	startOrder, dependents, orderErr := c.resolveProcessesOrder()
+	if orderErr != nil {
+		return orderErr
+	}
+	// Closed when process is stopped, so processes it depends on can be stopped after it.
+	processStopped := make([]chan struct{}, len(c.processes))
+	for i := range processStopped {
+		processStopped[i] = make(chan struct{})
+	}
+
 	// Create a context and its associated error group for the goroutines / processes.
 	processErrorGroup, processErrorGroupCtx := errgroup.WithContext(c.mainContext)
+	// Context given to processes on start, extended by shared values.
+	processStartCtx := process.WithShutdown(processErrorGroupCtx, c.shutdown)
+	for k, v := range c.startContextValues {
+		processStartCtx = context.WithValue(processStartCtx, k, v)
+	}
 
 	// Initialization of goroutines / processes.
-	for _, p := range c.processes {
-		proc := p // redefine the var within the scope of loop, so that each goroutine gets its own copy
+	for _, procIdx := range startOrder {
+		proc := c.processes[procIdx]
+		procIdx := procIdx // redefine the var within the scope of loop, so that each goroutine gets its own copy
 
 		// Define a goroutines / processes termination workflow.
 		processErrorGroup.Go(func() error {
+			defer close(processStopped[procIdx])
 			<-processErrorGroupCtx.Done()
 
+			// Graceful stop in reverse order, wait until all processes depending on this one are stopped.
+			for _, dependentIdx := range dependents[procIdx] {
+				<-processStopped[dependentIdx]
+			}
+
 			newUUID, errNewUUID := uuid.NewUUID()
 			if errNewUUID != nil {
 				return fmt.Errorf("unable to generate UUID for process, err: %w", errNewUUID)
@@ -123,7 +183,11 @@ func (c *ServiceCoordinator) Start() error {
 
 			defer procStopCtxCancel()
 
-			return proc.OnStop(procStopCtx) //nolint:contextcheck // false positive, extended by context.WithValue
+			stopStartedAt := time.Now()
+			stopErr := proc.OnStop(procStopCtx) //nolint:contextcheck // false positive, extended by context.WithValue
+			c.reportProcessTiming(proc.GetName(), ProcessPhaseStop, time.Since(stopStartedAt))
+
+			return stopErr
 		})
 
 		bgTasksWG.Add(1)
@@ -132,7 +196,9 @@ func (c *ServiceCoordinator) Start() error {
 		processErrorGroup.Go(func() error {
 			defer bgTasksWG.Done()
 
-			err := proc.OnStart(processErrorGroupCtx)
+			startStartedAt := time.Now()
+			err := proc.OnStart(processStartCtx)
+			c.reportProcessTiming(proc.GetName(), ProcessPhaseStart, time.Since(startStartedAt))
 			if err == nil {
 				return nil
 			}
@@ -171,11 +237,73 @@ func (c *ServiceCoordinator) Start() error {
 	return nil
 }
 
+// resolveProcessesOrder returns indexes of processes in dependency order, where dependencies go before dependent processes,
+// and for each process indexes of processes that depend on it, which is used to stop processes in reverse order.
+// Processes are launched in this order, but since OnStart is called concurrently it's not the order they start in.
+// Processes without declared dependencies keep order in which they were added.
+func (c *ServiceCoordinator) resolveProcessesOrder() (startOrder []int, dependents [][]int, err error) {
+	indexesByName := make(map[string][]int, len(c.processes))
+	for i, p := range c.processes {
+		indexesByName[p.GetName()] = append(indexesByName[p.GetName()], i)
+	}
+
+	dependents = make([][]int, len(c.processes))
+	dependenciesCount := make([]int, len(c.processes))
+	for i, p := range c.processes {
+		for _, dependencyName := range process.GetDependencies(p) {
+			dependencyIndexes, exist := indexesByName[dependencyName]
+			if !exist {
+				return nil, nil, fmt.Errorf("process %s depends on not added process %s", p.GetName(), dependencyName)
+			}
+
+			for _, dependencyIdx := range dependencyIndexes {
+				dependents[dependencyIdx] = append(dependents[dependencyIdx], i)
+				dependenciesCount[i]++
+			}
+		}
+	}
+
+	startOrder = make([]int, 0, len(c.processes))
+	for len(startOrder) < len(c.processes) {
+		isResolved := false
+		for i := range c.processes {
+			if dependenciesCount[i] != 0 {
+				continue
+			}
+
+			isResolved = true
+			dependenciesCount[i] = -1 // Mark as already ordered.
+			startOrder = append(startOrder, i)
+			for _, dependentIdx := range dependents[i] {
+				dependenciesCount[dependentIdx]--
+			}
+		}
+
+		if !isResolved {
+			return nil, nil, errors.New("unable to resolve order of processes, dependencies have a cycle")
+		}
+	}
+
+	return startOrder, dependents, nil
+}
+
+// reportProcessTiming to hook if it's set.
+func (c *ServiceCoordinator) reportProcessTiming(name, phase string, d time.Duration) {
+	if c.onProcessTiming != nil {
+		c.onProcessTiming(name, phase, d)
+	}
+}
+
 // Stop in graceful mode and terminate all goroutines / processes.
 func (c *ServiceCoordinator) Stop() error {
+	c.shutdown()
+
+	return nil
+}
+
+// shutdown is given to processes, so they could request graceful stop with process.Shutdown.
+func (c *ServiceCoordinator) shutdown() {
 	if c.mainContextCancel != nil {
 		c.mainContextCancel()
 	}
-
-	return nil
 }
@@ -117,6 +117,231 @@ func TestServiceCoordinator(t *testing.T) {
 	}
 }
 
+// orderedStubProcess records order of stop events and declares dependencies.
+type orderedStubProcess struct {
+	name      string
+	dependsOn []string
+	stopDelay time.Duration
+
+	mu        *sync.Mutex
+	stopOrder *[]string
+}
+
+func (m *orderedStubProcess) GetSeverity() process.Severity {
+	return process.TaskSeverityMajor
+}
+
+func (m *orderedStubProcess) OnStart(ctx context.Context) error {
+	<-ctx.Done()
+
+	return nil
+}
+
+func (m *orderedStubProcess) OnStop(_ context.Context) error {
+	time.Sleep(m.stopDelay)
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	*m.stopOrder = append(*m.stopOrder, m.name)
+
+	return nil
+}
+
+func (m *orderedStubProcess) GetName() string {
+	return m.name
+}
+
+func (m *orderedStubProcess) DependsOn() []string {
+	return m.dependsOn
+}
+
+func TestServiceCoordinatorStopsInReverseOrderOfDependencies(t *testing.T) {
+	var mu sync.Mutex
+	var stopOrder []string
+
+	// Delays make sure that without ordering processes would stop in opposite order.
+	exporter := &orderedStubProcess{name: "exporter", mu: &mu, stopOrder: &stopOrder}
+	server := &orderedStubProcess{name: "server", dependsOn: []string{"exporter"}, stopDelay: 10 * time.Millisecond, mu: &mu, stopOrder: &stopOrder}
+	gateway := &orderedStubProcess{name: "gateway", dependsOn: []string{"server"}, stopDelay: 20 * time.Millisecond, mu: &mu, stopOrder: &stopOrder}
+
+	sc := NewServiceCoordinator(AddProcesses(gateway, exporter, server), SetForceStopTimeout(time.Second))
+
+	startOrder, _, orderErr := sc.resolveProcessesOrder()
+	assert.NoError(t, orderErr)
+	assert.Equal(t, []int{1, 2, 0}, startOrder, "expected that dependencies go first")
+
+	startErr := make(chan error, 1)
+	go func() {
+		startErr <- sc.Start()
+	}()
+
+	time.Sleep(10 * time.Millisecond)
+	assert.NoError(t, sc.Stop())
+
+	select {
+	case err := <-startErr:
+		assert.NoError(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("ServiceCoordinator was not stopped in the expected timeframe")
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, []string{"gateway", "server", "exporter"}, stopOrder)
+}
+
+func TestServiceCoordinatorWithInvalidDependencies(t *testing.T) {
+	var mu sync.Mutex
+	var stopOrder []string
+
+	unknownDependency := NewServiceCoordinator(AddProcesses(
+		&orderedStubProcess{name: "server", dependsOn: []string{"exporter"}, mu: &mu, stopOrder: &stopOrder},
+	))
+	assert.Error(t, unknownDependency.Start())
+
+	cycleDependency := NewServiceCoordinator(AddProcesses(
+		&orderedStubProcess{name: "server", dependsOn: []string{"exporter"}, mu: &mu, stopOrder: &stopOrder},
+		&orderedStubProcess{name: "exporter", dependsOn: []string{"server"}, mu: &mu, stopOrder: &stopOrder},
+	))
+	assert.Error(t, cycleDependency.Start())
+}
+
+// shutdownStubProcess requests shutdown of the whole service on start.
+type shutdownStubProcess struct {
+	isShutdownRequested bool
+}
+
+func (m *shutdownStubProcess) GetSeverity() process.Severity {
+	return process.TaskSeverityMinor
+}
+
+func (m *shutdownStubProcess) OnStart(ctx context.Context) error {
+	m.isShutdownRequested = process.Shutdown(ctx)
+
+	return nil
+}
+
+func (m *shutdownStubProcess) OnStop(_ context.Context) error {
+	return nil
+}
+
+func (m *shutdownStubProcess) GetName() string {
+	return "UnitTestShutdownStubProcess"
+}
+
+func TestServiceCoordinatorShutdownRequestedByProcess(t *testing.T) {
+	var mu sync.Mutex
+	var stopOrder []string
+
+	longRunning := &orderedStubProcess{name: "server", mu: &mu, stopOrder: &stopOrder}
+	shutdownRequester := &shutdownStubProcess{}
+
+	sc := NewServiceCoordinator(AddProcesses(longRunning, shutdownRequester), SetForceStopTimeout(time.Second))
+
+	startErr := make(chan error, 1)
+	go func() {
+		startErr <- sc.Start()
+	}()
+
+	select {
+	case err := <-startErr:
+		assert.NoError(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("ServiceCoordinator was not stopped by process in the expected timeframe")
+	}
+
+	assert.True(t, shutdownRequester.isShutdownRequested)
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, []string{"server"}, stopOrder, "expected that all processes are stopped")
+}
+
+// valueStubProcess reads value from start context and requests shutdown.
+type valueStubProcess struct {
+	key   any
+	value any
+}
+
+func (m *valueStubProcess) GetSeverity() process.Severity {
+	return process.TaskSeverityMinor
+}
+
+func (m *valueStubProcess) OnStart(ctx context.Context) error {
+	m.value = ctx.Value(m.key)
+	process.Shutdown(ctx)
+
+	return nil
+}
+
+func (m *valueStubProcess) OnStop(_ context.Context) error {
+	return nil
+}
+
+func (m *valueStubProcess) GetName() string {
+	return "UnitTestValueStubProcess"
+}
+
+func TestServiceCoordinatorWithStartContextValues(t *testing.T) {
+	type loggerKey struct{}
+
+	valueReader := &valueStubProcess{key: loggerKey{}}
+	sc := NewServiceCoordinator(
+		AddProcesses(valueReader),
+		WithStartContextValues(map[any]any{loggerKey{}: "unit-logger"}),
+		SetForceStopTimeout(time.Second),
+	)
+
+	startErr := make(chan error, 1)
+	go func() {
+		startErr <- sc.Start()
+	}()
+
+	select {
+	case err := <-startErr:
+		assert.NoError(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("ServiceCoordinator was not stopped by process in the expected timeframe")
+	}
+
+	assert.Equal(t, "unit-logger", valueReader.value)
+}
+
+func TestServiceCoordinatorOnProcessTiming(t *testing.T) {
+	var mu sync.Mutex
+	var stopOrder []string
+	timings := make(map[string]time.Duration)
+
+	slowStopping := &orderedStubProcess{name: "server", stopDelay: 10 * time.Millisecond, mu: &mu, stopOrder: &stopOrder}
+	shutdownRequester := &shutdownStubProcess{}
+
+	sc := NewServiceCoordinator(
+		AddProcesses(slowStopping, shutdownRequester),
+		SetForceStopTimeout(time.Second),
+		OnProcessTiming(func(name, phase string, d time.Duration) {
+			mu.Lock()
+			defer mu.Unlock()
+
+			timings[name+"/"+phase] = d
+		}),
+	)
+
+	assert.NoError(t, sc.Start())
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Len(t, timings, 4, "expected start and stop timing of each process")
+	assert.Contains(t, timings, "server/"+ProcessPhaseStart)
+	assert.Contains(t, timings, "UnitTestShutdownStubProcess/"+ProcessPhaseStop)
+	assert.True(t, timings["server/"+ProcessPhaseStop] >= 10*time.Millisecond)
+	assert.True(t, timings["server/"+ProcessPhaseStop] < time.Second)
+}
+
+func TestShutdownWithoutServiceCoordinator(t *testing.T) {
+	assert.False(t, process.Shutdown(context.Background()))
+}
+
 func exampleNewHTTPServer() *stubProcess {
 	return new(stubProcess)
 }
@@ -6,10 +6,14 @@
 // To use this package, implement the Process interface, which includes methods to
 // trigger events like OnStart and OnStop, ensuring integration and control
 // over your application's processes via coordinator.ServiceCoordinator.
+// Process can also request graceful stop of the whole service by calling Shutdown with its start context.
 package process
 
 import "context"
 
+// shutdownContextKey is a key of shutdown function in context given to Process.OnStart.
+type shutdownContextKey struct{}
+
 // Severity is an enumerated type representing the importance of a background task to be executed.
 type Severity byte
 
@@ -33,7 +37,43 @@ type Process interface {
 	OnStop(ctx context.Context) error
 }
 
+// Dependent is an optional interface of Process to declare processes it depends on.
+// Dependencies are gracefully stopped after the Dependent process, so for example HTTP server stops
+// before the metrics exporter it uses. Start order is not guaranteed, OnStart of all processes is called concurrently.
+type Dependent interface {
+	// DependsOn method returns names (see Process.GetName) of processes this process depends on.
+	DependsOn() []string
+}
+
+// GetDependencies returns names of processes the task depends on, if it implements Dependent.
+func GetDependencies(t Process) []string {
+	if d, ok := t.(Dependent); ok {
+		return d.DependsOn()
+	}
+
+	return nil
+}
+
 // IsCriticalToStop checks if the task is essential for execution.
 func IsCriticalToStop(t Process) bool {
 	return t.GetSeverity() == TaskSeverityMajor
 }
+
+// WithShutdown returns a copy of ctx with shutdown function, used by coordinator to give it to Process.OnStart.
+func WithShutdown(ctx context.Context, shutdown func()) context.Context {
+	return context.WithValue(ctx, shutdownContextKey{}, shutdown)
+}
+
+// Shutdown requests graceful termination of the whole service from the process,
+// ctx must be the context given to Process.OnStart or derived from it.
+// It returns false if ctx has no shutdown function, for example it was not started by coordinator.
+func Shutdown(ctx context.Context) bool {
+	shutdown, ok := ctx.Value(shutdownContextKey{}).(func())
+	if !ok {
+		return false
+	}
+
+	shutdown()
+
+	return true
+}
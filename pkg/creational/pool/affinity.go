@@ -0,0 +1,98 @@
+package pool
+
+import (
+	"context"
+	"time"
+)
+
+// AcquireAffinity retrieves resource that was previously acquired with the same key if it's available,
+// so the same request or shard can use the same resource again (sticky sessions).
+// If such resource is taken, reached usage limit or not managed anymore, then any available resource is acquired
+// same as AcquireResource and becomes associated with the key.
+// Resource keeps association only with the latest key, and association is removed when resource is destroyed
+// or detached, so amount of kept keys is limited by the size of pool.
+func (rpm *ResourcePoolManager[T]) AcquireAffinity(ctx context.Context, key string, isNeedToRetryOnTaken bool) (*T, error) {
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+
+	startedAt := time.Now()
+	if r, ok := rpm.acquireAffinityResource(key); ok {
+		rpm.acquireLatency.record(time.Since(startedAt))
+		return r, nil
+	}
+
+	r, rErr := rpm.AcquireResource(ctx, isNeedToRetryOnTaken)
+	if rErr != nil {
+		return nil, rErr
+	}
+
+	rpm.associateAffinity(key, r)
+
+	return r, nil
+}
+
+// associateAffinity associates key with resource instead of the previous key of the resource.
+func (rpm *ResourcePoolManager[T]) associateAffinity(key string, r *T) {
+	value, ok := rpm.pool.Load(r)
+	if !ok {
+		return
+	}
+
+	mr, _ := value.(*managedResource[T]) //nolint:errcheck // value is already found by key, and it's strictly controlled how it's stored.
+	mr.mu.Lock()
+	defer mr.mu.Unlock()
+
+	// Resource could be detached or destroyed while waiting for the lock.
+	if current, isManaged := rpm.pool.Load(r); !isManaged || current != value {
+		return
+	}
+
+	if mr.affinityKey != key {
+		rpm.affinity.CompareAndDelete(mr.affinityKey, r)
+		mr.affinityKey = key
+	}
+	rpm.affinity.Store(key, r)
+}
+
+// acquireAffinityResource tries to acquire resource associated with key, stale association is removed.
+func (rpm *ResourcePoolManager[T]) acquireAffinityResource(key string) (*T, bool) {
+	value, ok := rpm.affinity.Load(key)
+	if !ok {
+		return nil, false
+	}
+
+	r, _ := value.(*T) //nolint:errcheck // it's strictly controlled how it's stored.
+	mrValue, ok := rpm.pool.Load(r)
+	if !ok {
+		rpm.affinity.CompareAndDelete(key, value)
+		return nil, false
+	}
+
+	mr, _ := mrValue.(*managedResource[T]) //nolint:errcheck // value is already found by key, and it's strictly controlled how it's stored.
+	mr.mu.Lock()
+	defer mr.mu.Unlock()
+
+	if mr.isAcquired || (rpm.resourceUsageLimit != 0 && mr.usageCount >= rpm.resourceUsageLimit) {
+		return nil, false
+	}
+
+	// Resource could be detached or destroyed while waiting for the lock.
+	if current, isManaged := rpm.pool.Load(r); !isManaged || current != mrValue {
+		rpm.affinity.CompareAndDelete(key, value)
+		return nil, false
+	}
+
+	if !rpm.isHealthy(r) {
+		_ = rpm.destroyManagedResource(r) //nolint:errcheck // resource is not usable anyway, there is no one to report error to.
+		rpm.notifyWaiters()
+
+		return nil, false
+	}
+
+	mr.usageCount++
+	mr.isAcquired = true
+	rpm.trackAcquired(1)
+
+	return r, true
+}
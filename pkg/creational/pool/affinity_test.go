@@ -0,0 +1,95 @@
+package pool
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAcquireAffinity(t *testing.T) {
+	manager := NewResourcePoolManager[stubResource](3, 0, new(stubFactory))
+	unitContext := context.TODO()
+
+	firstRes, ackErr := manager.AcquireAffinity(unitContext, "shard-1", false)
+	assert.NoError(t, ackErr)
+	secondRes, ackErr := manager.AcquireAffinity(unitContext, "shard-2", false)
+	assert.NoError(t, ackErr)
+	assert.NotSame(t, firstRes, secondRes)
+
+	manager.ReleaseResource(firstRes)
+	manager.ReleaseResource(secondRes)
+
+	for i := 0; i < 3; i++ {
+		res, resErr := manager.AcquireAffinity(unitContext, "shard-2", false)
+		assert.NoError(t, resErr)
+		assert.Same(t, secondRes, res, "expected to get back resource associated with key")
+		manager.ReleaseResource(res)
+	}
+
+	res, resErr := manager.AcquireAffinity(unitContext, "shard-1", false)
+	assert.NoError(t, resErr)
+	assert.Same(t, firstRes, res, "expected to get back resource associated with key")
+	manager.ReleaseResource(res)
+}
+
+func TestAcquireAffinityFallback(t *testing.T) {
+	manager := NewResourcePoolManager[stubResource](2, 0, new(stubFactory))
+	unitContext := context.TODO()
+
+	affinityRes, ackErr := manager.AcquireAffinity(unitContext, "shard-1", false)
+	assert.NoError(t, ackErr)
+
+	// Resource is taken, so another one must be given and associated with the key.
+	fallbackRes, ackErr := manager.AcquireAffinity(unitContext, "shard-1", false)
+	assert.NoError(t, ackErr)
+	assert.NotSame(t, affinityRes, fallbackRes)
+
+	manager.ReleaseResource(affinityRes)
+	manager.ReleaseResource(fallbackRes)
+
+	res, resErr := manager.AcquireAffinity(unitContext, "shard-1", false)
+	assert.NoError(t, resErr)
+	assert.Same(t, fallbackRes, res)
+
+	// Not managed resource must not be given by affinity.
+	manager.DetachResource(res)
+
+	newRes, newResErr := manager.AcquireAffinity(unitContext, "shard-1", false)
+	assert.NoError(t, newResErr)
+	assert.NotSame(t, res, newRes)
+}
+
+func TestAcquireAffinityKeysAreRemoved(t *testing.T) {
+	manager := NewResourcePoolManager[stubResource](2, 3, new(stubFactory))
+	unitContext := context.TODO()
+
+	countKeys := func() int {
+		count := 0
+		manager.affinity.Range(func(_, _ any) bool {
+			count++
+			return true
+		})
+
+		return count
+	}
+
+	for i := 0; i < 10; i++ {
+		res, ackErr := manager.AcquireAffinity(unitContext, fmt.Sprintf("request-%d", i), false)
+		assert.NoError(t, ackErr)
+		manager.ReleaseResource(res)
+		assert.LessOrEqual(t, countKeys(), 1, "expected that resource keeps only the latest key")
+	}
+
+	res, ackErr := manager.AcquireAffinity(unitContext, "request-10", false)
+	assert.NoError(t, ackErr)
+	manager.DetachResource(res)
+	assert.Equal(t, 0, countKeys(), "expected that key of detached resource is removed")
+
+	res, ackErr = manager.AcquireAffinity(unitContext, "request-11", false)
+	assert.NoError(t, ackErr)
+	manager.ReleaseResource(res)
+	assert.NoError(t, manager.CleanUpManagedResources(unitContext))
+	assert.Equal(t, 0, countKeys(), "expected that key of destroyed resource is removed")
+}
@@ -0,0 +1,33 @@
+package pool
+
+import (
+	"bytes"
+	"context"
+)
+
+// BufferPool of reusable bytes.Buffer, similar to sync.Pool but with limited size.
+// Buffers are reset when returned to the pool, so each Get returns empty buffer.
+type BufferPool struct {
+	manager *ResourcePoolManager[bytes.Buffer]
+}
+
+// NewBufferPool creates BufferPool that holds up to size buffers,
+// 255 or more means that size is not limited, see New.
+func NewBufferPool(size int) *BufferPool {
+	return &BufferPool{
+		manager: New(size,
+			func() *bytes.Buffer { return new(bytes.Buffer) },
+			WithReset(func(b *bytes.Buffer) { b.Reset() }),
+		),
+	}
+}
+
+// Get empty buffer from the pool, if all buffers are taken it waits until one is returned or ctx is done.
+func (bp *BufferPool) Get(ctx context.Context) (*bytes.Buffer, error) {
+	return bp.manager.AcquireResource(ctx, true)
+}
+
+// Put buffer back to the pool, it must not be used after that.
+func (bp *BufferPool) Put(b *bytes.Buffer) {
+	bp.manager.ReleaseResource(b)
+}
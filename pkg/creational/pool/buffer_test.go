@@ -0,0 +1,58 @@
+package pool
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBufferPool(t *testing.T) {
+	bp := NewBufferPool(1)
+	unitContext := context.TODO()
+
+	b, getErr := bp.Get(unitContext)
+	assert.NoError(t, getErr)
+	b.WriteString("unit")
+
+	timeoutContext, cancel := context.WithTimeout(unitContext, 10*time.Millisecond)
+	defer cancel()
+	_, getErr = bp.Get(timeoutContext)
+	assert.Error(t, getErr, "expected to wait for buffer until context is done")
+
+	bp.Put(b)
+
+	reusedBuffer, getErr := bp.Get(unitContext)
+	assert.NoError(t, getErr)
+	assert.Same(t, b, reusedBuffer, "expected that buffer is reused")
+	assert.Equal(t, 0, reusedBuffer.Len(), "expected that buffer is reset")
+	assert.True(t, reusedBuffer.Cap() > 0, "expected that allocated memory is kept")
+	bp.Put(reusedBuffer)
+}
+
+var benchmarkPayload = bytes.Repeat([]byte("unit"), 1024)
+
+func BenchmarkBufferPool(b *testing.B) {
+	bp := NewBufferPool(1)
+	unitContext := context.TODO()
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		buf, getErr := bp.Get(unitContext)
+		if getErr != nil {
+			b.Fatal("Benchmark failed with error:", getErr)
+		}
+		buf.Write(benchmarkPayload)
+		bp.Put(buf)
+	}
+}
+
+func BenchmarkBufferAllocation(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		buf := new(bytes.Buffer)
+		buf.Write(benchmarkPayload)
+	}
+}
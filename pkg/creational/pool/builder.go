@@ -0,0 +1,79 @@
+package pool
+
+import "context"
+
+type (
+	// builderAdapter makes ResourceBuilder of ResourceBuilderE or ResourceBuilderContext,
+	// optional ResourceResetter, ResourceValidator, ResourceHealthChecker and ResourceDeconstructorE of wrapped builder are kept.
+	builderAdapter[T Resource] struct {
+		builder   interface{ Deconstruction(*T) }
+		construct func(ctx context.Context) (*T, error)
+	}
+)
+
+// AdaptBuilderE allows to use ResourceBuilderE in NewResourcePoolManager,
+// so errors of construction are returned by AcquireResource wrapped with ErrorResourceConstruction.
+func AdaptBuilderE[T Resource](builder ResourceBuilderE[T]) ResourceBuilder[T] {
+	return &builderAdapter[T]{
+		builder:   builder,
+		construct: func(context.Context) (*T, error) { return builder.ConstructE() },
+	}
+}
+
+// AdaptBuilderContext allows to use ResourceBuilderContext in NewResourcePoolManager,
+// so construction gets context of acquisition and its errors are returned by AcquireResource wrapped with ErrorResourceConstruction.
+func AdaptBuilderContext[T Resource](builder ResourceBuilderContext[T]) ResourceBuilder[T] {
+	return &builderAdapter[T]{builder: builder, construct: builder.ConstructContext}
+}
+
+// Construct is used only if adapter is called as ResourceBuilder outside ResourcePoolManager, error is dropped.
+func (a *builderAdapter[T]) Construct() *T {
+	r, _ := a.construct(context.Background()) //nolint:errcheck // ResourcePoolManager uses ConstructContext, there is no way to return error here.
+	return r
+}
+
+// ConstructContext creates resource with wrapped builder.
+func (a *builderAdapter[T]) ConstructContext(ctx context.Context) (*T, error) {
+	return a.construct(ctx)
+}
+
+// Deconstruction destroys resource with wrapped builder.
+func (a *builderAdapter[T]) Deconstruction(r *T) {
+	a.builder.Deconstruction(r)
+}
+
+// DeconstructionE destroys resource if wrapped builder implements ResourceDeconstructorE,
+// otherwise Deconstruction is used.
+func (a *builderAdapter[T]) DeconstructionE(r *T) error {
+	if deconstructor, isDeconstructor := a.builder.(ResourceDeconstructorE[T]); isDeconstructor {
+		return deconstructor.DeconstructionE(r)
+	}
+	a.builder.Deconstruction(r)
+
+	return nil
+}
+
+// Reset cleans resource if wrapped builder implements ResourceResetter.
+func (a *builderAdapter[T]) Reset(r *T) {
+	if resetter, isResetter := a.builder.(ResourceResetter[T]); isResetter {
+		resetter.Reset(r)
+	}
+}
+
+// Validate checks resource if wrapped builder implements ResourceValidator.
+func (a *builderAdapter[T]) Validate(r *T) error {
+	if validator, isValidator := a.builder.(ResourceValidator[T]); isValidator {
+		return validator.Validate(r)
+	}
+
+	return nil
+}
+
+// HealthCheck checks resource if wrapped builder implements ResourceHealthChecker.
+func (a *builderAdapter[T]) HealthCheck(r *T) bool {
+	if checker, isChecker := a.builder.(ResourceHealthChecker[T]); isChecker {
+		return checker.HealthCheck(r)
+	}
+
+	return true
+}
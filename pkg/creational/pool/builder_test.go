@@ -0,0 +1,169 @@
+package pool
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// stubFailingFactory fails to construct resources while constructErr is set.
+type stubFailingFactory struct {
+	constructErr error
+	resetCount   int
+}
+
+func (m *stubFailingFactory) ConstructE() (*stubResource, error) {
+	if m.constructErr != nil {
+		return nil, m.constructErr
+	}
+
+	return &stubResource{SomeValue: "NewOne"}, nil
+}
+
+func (m *stubFailingFactory) Deconstruction(r *stubResource) {
+	r.SomeValue = ""
+}
+
+func (m *stubFailingFactory) Reset(r *stubResource) {
+	m.resetCount++
+	r.SomeWork = false
+}
+
+func TestAcquireResourceWithFailingConstruction(t *testing.T) {
+	dialErr := errors.New("connection refused")
+	factory := &stubFailingFactory{constructErr: dialErr}
+	manager := NewResourcePoolManager[stubResource](1, 0, AdaptBuilderE[stubResource](factory))
+	unitContext := context.TODO()
+
+	r, ackErr := manager.AcquireResource(unitContext, true)
+	assert.ErrorIs(t, ackErr, ErrorResourceConstruction)
+	assert.ErrorIs(t, ackErr, dialErr)
+	assert.Nil(t, r)
+	assert.False(t, manager.IsSaturated(), "expected that failed construction doesn't take place in pool")
+
+	warmed, warmUpErr := manager.WarmUp(unitContext, 1)
+	assert.ErrorIs(t, warmUpErr, dialErr)
+	assert.Equal(t, 0, warmed)
+
+	factory.constructErr = nil
+	r, ackErr = manager.AcquireResource(unitContext, false)
+	assert.NoError(t, ackErr)
+	assert.Equal(t, "NewOne", r.SomeValue)
+
+	r.SomeWork = true
+	manager.ReleaseResource(r)
+	assert.Equal(t, 1, factory.resetCount, "expected that adapter keeps ResourceResetter")
+	assert.False(t, r.SomeWork)
+}
+
+type stubDialContextKey struct{}
+
+// stubContextFactory constructs resource only if context has address to dial.
+type stubContextFactory struct{}
+
+func (m *stubContextFactory) ConstructContext(ctx context.Context) (*stubResource, error) {
+	address, ok := ctx.Value(stubDialContextKey{}).(string)
+	if !ok {
+		return nil, errors.New("no address to dial")
+	}
+
+	return &stubResource{SomeValue: address, someExternalObject: ctx}, nil
+}
+
+func (m *stubContextFactory) Deconstruction(r *stubResource) {
+	r.someExternalObject = nil
+}
+
+func TestAcquireResourceWithContextConstruction(t *testing.T) {
+	manager := NewResourcePoolManager[stubResource](1, 0, AdaptBuilderContext[stubResource](new(stubContextFactory)))
+
+	r, ackErr := manager.AcquireResource(context.TODO(), false)
+	assert.ErrorIs(t, ackErr, ErrorResourceConstruction)
+	assert.Nil(t, r)
+	assert.Equal(t, PoolStats{}, manager.Stats(), "expected that failed construction leaves nothing in pool")
+
+	dialContext := context.WithValue(context.TODO(), stubDialContextKey{}, "127.0.0.1:5432")
+	r, ackErr = manager.AcquireResource(dialContext, false)
+	assert.NoError(t, ackErr, "expected that failed construction doesn't count against pool size")
+	assert.Equal(t, "127.0.0.1:5432", r.SomeValue, "expected that construction gets context of acquisition")
+	assert.Equal(t, 1, manager.Stats().Total)
+}
+
+// stubBlockingFactory blocks construction until context is done or delay passed.
+type stubBlockingFactory struct {
+	delay time.Duration
+}
+
+func (m *stubBlockingFactory) ConstructContext(ctx context.Context) (*stubResource, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-time.After(m.delay):
+		return new(stubResource), nil
+	}
+}
+
+func (m *stubBlockingFactory) Deconstruction(_ *stubResource) {}
+
+// stubSlowFactory blocks construction for delay and can't be interrupted.
+type stubSlowFactory struct {
+	delay time.Duration
+}
+
+func (m *stubSlowFactory) Construct() *stubResource {
+	time.Sleep(m.delay)
+
+	return new(stubResource)
+}
+
+func (m *stubSlowFactory) Deconstruction(_ *stubResource) {}
+
+// waitForGoroutines samples runtime.NumGoroutine until it's not above expected count or timeout passed.
+func waitForGoroutines(expected int, timeout time.Duration) bool {
+	deadline := time.Now().Add(timeout)
+	for runtime.NumGoroutine() > expected {
+		if time.Now().After(deadline) {
+			return false
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	return true
+}
+
+func TestAcquireResourceCanceledDuringConstructionDoesNotLeak(t *testing.T) {
+	goroutinesBefore := runtime.NumGoroutine()
+
+	manager := NewResourcePoolManager[stubResource](5, 0, AdaptBuilderContext[stubResource](&stubBlockingFactory{delay: time.Hour}))
+	for i := 0; i < 5; i++ {
+		unitContext, cancel := context.WithTimeout(context.TODO(), time.Millisecond)
+		_, ackErr := manager.AcquireResource(unitContext, false)
+		cancel()
+		assert.ErrorIs(t, ackErr, context.DeadlineExceeded)
+	}
+
+	assert.True(t, waitForGoroutines(goroutinesBefore, time.Second), "expected that construction goroutines are finished")
+	assert.Equal(t, PoolStats{}, manager.Stats(), "expected that canceled construction leaves nothing in pool")
+}
+
+func TestAcquireResourceCanceledDuringConstructionReturnsResourceToPool(t *testing.T) {
+	goroutinesBefore := runtime.NumGoroutine()
+
+	// Factory without context can't be interrupted, so resource is constructed after acquisition is canceled.
+	manager := NewResourcePoolManager[stubResource](1, 0, &stubSlowFactory{delay: 20 * time.Millisecond})
+	unitContext, cancel := context.WithTimeout(context.TODO(), time.Millisecond)
+	defer cancel()
+
+	_, ackErr := manager.AcquireResource(unitContext, false)
+	assert.ErrorIs(t, ackErr, context.DeadlineExceeded)
+
+	assert.True(t, waitForGoroutines(goroutinesBefore, time.Second), "expected that construction goroutine is finished")
+	assert.Equal(t, PoolStats{Total: 1, Idle: 1, Peak: 1}, manager.Stats(), "expected that resource is not stranded as acquired")
+
+	_, ackErr = manager.AcquireResource(context.TODO(), false)
+	assert.NoError(t, ackErr)
+}
@@ -0,0 +1,66 @@
+package pool
+
+import "context"
+
+// CleanUpSummary reports what was done by CleanUp, for example to log it on shutdown.
+type CleanUpSummary struct {
+	// Destroyed count of resources that were removed from pool and deconstructed.
+	Destroyed int
+	// Errors returned by ResourceDeconstructorE, such resources are removed from pool and counted as destroyed anyway.
+	Errors []error
+}
+
+// CleanUp destroys all resources managed by ResourcePool, both idle and acquired, and reports what was destroyed.
+// ctx is checked before each resource, if it's done CleanUp stops and returns summary of the work done so far with ctx error.
+func (rpm *ResourcePoolManager[T]) CleanUp(ctx context.Context) (CleanUpSummary, error) {
+	var summary CleanUpSummary
+	if ctx.Err() != nil {
+		return summary, ctx.Err()
+	}
+
+	var resources []*managedResource[T]
+	rpm.pool.Range(func(_, value any) bool {
+		if mr, ok := value.(*managedResource[T]); ok {
+			resources = append(resources, mr)
+		}
+
+		return true
+	})
+	defer rpm.notifyWaiters()
+
+	for _, mr := range resources {
+		if ctx.Err() != nil {
+			return summary, ctx.Err()
+		}
+
+		isDestroyed, destroyErr := rpm.cleanUpManagedResource(mr)
+		if !isDestroyed {
+			continue
+		}
+
+		summary.Destroyed++
+		if destroyErr != nil {
+			summary.Errors = append(summary.Errors, destroyErr)
+		}
+	}
+
+	return summary, nil
+}
+
+// cleanUpManagedResource destroys resource if it's still managed by ResourcePool.
+func (rpm *ResourcePoolManager[T]) cleanUpManagedResource(mr *managedResource[T]) (bool, error) {
+	mr.mu.Lock()
+	defer mr.mu.Unlock()
+
+	// Resource could be destroyed or detached by another goroutine meanwhile.
+	if current, isManaged := rpm.pool.Load(mr.resource); !isManaged || current != mr {
+		return false, nil
+	}
+
+	destroyErr := rpm.destroyManagedResource(mr.resource)
+	if mr.isAcquired {
+		rpm.trackAcquired(-1)
+	}
+
+	return true, destroyErr
+}
@@ -0,0 +1,80 @@
+package pool
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// stubFailingDeconstructionFactory fails to deconstruct resources with SomeWork set.
+type stubFailingDeconstructionFactory struct {
+	stubFactory
+}
+
+func (m *stubFailingDeconstructionFactory) DeconstructionE(r *stubResource) error {
+	if r.SomeWork {
+		return errors.New("unable to close connection")
+	}
+	m.Deconstruction(r)
+
+	return nil
+}
+
+func TestCleanUp(t *testing.T) {
+	manager := NewResourcePoolManager[stubResource](3, 0, new(stubFailingDeconstructionFactory))
+	unitContext := context.TODO()
+
+	warmed, warmUpErr := manager.WarmUp(unitContext, 2)
+	assert.NoError(t, warmUpErr)
+	assert.Equal(t, 2, warmed)
+
+	acquiredRes, ackErr := manager.AcquireResource(unitContext, false)
+	assert.NoError(t, ackErr)
+	acquiredRes.SomeWork = true
+	totalBefore := manager.Stats().Total
+
+	summary, cleanUpErr := manager.CleanUp(unitContext)
+	assert.NoError(t, cleanUpErr)
+	assert.Equal(t, totalBefore, summary.Destroyed)
+	assert.Len(t, summary.Errors, 1)
+	assert.Equal(t, PoolStats{Peak: 1}, manager.Stats(), "expected that pool is empty")
+
+	manager.ReleaseResource(acquiredRes) // No-op, resource is not managed anymore.
+
+	summary, cleanUpErr = manager.CleanUp(unitContext)
+	assert.NoError(t, cleanUpErr)
+	assert.Equal(t, CleanUpSummary{}, summary)
+}
+
+func TestCleanUpWithCanceledContext(t *testing.T) {
+	manager := NewResourcePoolManager[stubResource](3, 0, new(stubFactory))
+	_, warmUpErr := manager.WarmUp(context.TODO(), 3)
+	assert.NoError(t, warmUpErr)
+
+	canceledCtx, cancel := context.WithCancel(context.TODO())
+	cancel()
+
+	summary, cleanUpErr := manager.CleanUp(canceledCtx)
+	assert.ErrorIs(t, cleanUpErr, context.Canceled)
+	assert.Equal(t, 0, summary.Destroyed)
+	assert.Equal(t, 3, manager.Stats().Total)
+}
+
+func TestCleanUpManagedResourcesReportsDeconstructionErrors(t *testing.T) {
+	manager := NewResourcePoolManager[stubResource](2, 0, new(stubFailingDeconstructionFactory))
+	unitContext := context.TODO()
+
+	failingRes, ackErr := manager.AcquireResource(unitContext, false)
+	assert.NoError(t, ackErr)
+	failingRes.SomeWork = true
+	_, ackErr = manager.AcquireResource(unitContext, false)
+	assert.NoError(t, ackErr)
+
+	cleanUpErr := manager.CleanUpManagedResources(unitContext)
+	assert.ErrorContains(t, cleanUpErr, "unable to close connection")
+	assert.Equal(t, 0, manager.Stats().Total)
+
+	assert.NoError(t, manager.CleanUpManagedResources(unitContext), "expected no error when nothing failed")
+}
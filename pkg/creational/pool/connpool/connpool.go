@@ -0,0 +1,115 @@
+// Package connpool is a subpackage of pool, providing ready to use pool of network connections (net.Conn).
+//
+// ConnPool wraps pool.ResourcePoolManager with factory that dials connections
+// by given network and address, and closes them when pool decides to destroy them.
+package connpool
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/coopnorge/member-lib/pkg/creational/pool"
+)
+
+const (
+	defaultDialTimeout = 30 * time.Second
+	defaultPoolSize    = 10
+)
+
+type (
+	// Config of ConnPool.
+	Config struct {
+		// Network name as in net.Dial, like "tcp" or "unix".
+		Network string
+		// Address to dial as in net.Dial, like "127.0.0.1:8080".
+		Address string
+		// DialTimeout maximum duration to establish connection, if not set then 30 seconds used.
+		DialTimeout time.Duration
+		// PoolSize maximum amount of connections managed by ConnPool, if not set then 10 used.
+		PoolSize uint8
+		// ConnUsageLimit how many times connection can be taken before it will be closed, '0' is unlimited.
+		ConnUsageLimit uint8
+	}
+
+	// Conn is net.Conn managed by ConnPool.
+	Conn struct {
+		net.Conn
+	}
+
+	// ConnPool manages pool of network connections.
+	ConnPool struct {
+		manager *pool.ResourcePoolManager[Conn]
+	}
+
+	// connFactory dials and closes connections for pool.ResourcePoolManager.
+	connFactory struct {
+		network     string
+		address     string
+		dialTimeout time.Duration
+	}
+)
+
+// NewConnPool creates ConnPool, connections are dialed lazily when needed.
+func NewConnPool(cfg *Config) *ConnPool {
+	factory := &connFactory{network: cfg.Network, address: cfg.Address, dialTimeout: cfg.DialTimeout}
+	if factory.dialTimeout == 0 {
+		factory.dialTimeout = defaultDialTimeout
+	}
+
+	poolSize := cfg.PoolSize
+	if poolSize == 0 {
+		poolSize = defaultPoolSize
+	}
+
+	return &ConnPool{
+		manager: pool.NewResourcePoolManager[Conn](poolSize, cfg.ConnUsageLimit, pool.AdaptBuilderContext[Conn](factory)),
+	}
+}
+
+// Get takes available connection from ConnPool or dials new one,
+// if all connections are taken it waits until one will be returned or context.Context will be canceled.
+// Dialing is limited by DialTimeout and ctx, dial error is returned wrapped with pool.ErrorResourceConstruction.
+func (cp *ConnPool) Get(ctx context.Context) (*Conn, error) {
+	return cp.manager.AcquireResource(ctx, true)
+}
+
+// Put returns connection back to ConnPool so it could be reused.
+func (cp *ConnPool) Put(c *Conn) {
+	cp.manager.ReleaseResource(c)
+}
+
+// Discard removes broken connection from ConnPool and closes it instead of returning it for reuse,
+// so place in pool is freed for a new connection.
+func (cp *ConnPool) Discard(c *Conn) error {
+	cp.manager.DetachResource(c)
+
+	return c.Conn.Close()
+}
+
+// Close all connections managed by ConnPool.
+func (cp *ConnPool) Close(ctx context.Context) error {
+	return cp.manager.CleanUpManagedResources(ctx)
+}
+
+// ConstructContext dials new connection within dial timeout, dialing is canceled when ctx is done.
+func (f *connFactory) ConstructContext(ctx context.Context) (*Conn, error) {
+	dialer := net.Dialer{Timeout: f.dialTimeout}
+	c, dialErr := dialer.DialContext(ctx, f.network, f.address)
+	if dialErr != nil {
+		return nil, fmt.Errorf("unable to dial %s %s: %w", f.network, f.address, dialErr)
+	}
+
+	return &Conn{Conn: c}, nil
+}
+
+// Deconstruction closes connection, it's used only if pool doesn't support DeconstructionE.
+func (f *connFactory) Deconstruction(c *Conn) {
+	_ = f.DeconstructionE(c) //nolint:errcheck // there is no one to report error to.
+}
+
+// DeconstructionE closes connection, error is reported by ConnPool.Close.
+func (f *connFactory) DeconstructionE(c *Conn) error {
+	return c.Conn.Close()
+}
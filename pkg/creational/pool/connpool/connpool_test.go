@@ -0,0 +1,150 @@
+package connpool
+
+import (
+	"bufio"
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/coopnorge/member-lib/pkg/creational/pool"
+)
+
+func newEchoListener(t *testing.T) net.Listener {
+	listener, listenErr := net.Listen("tcp", "127.0.0.1:0")
+	if listenErr != nil {
+		t.Fatalf("unable to listen: %v", listenErr)
+	}
+
+	go func() {
+		for {
+			c, acceptErr := listener.Accept()
+			if acceptErr != nil {
+				return
+			}
+
+			go func(c net.Conn) {
+				defer c.Close()
+
+				scanner := bufio.NewScanner(c)
+				for scanner.Scan() {
+					_, _ = c.Write(append(scanner.Bytes(), '\n'))
+				}
+			}(c)
+		}
+	}()
+
+	return listener
+}
+
+func TestConnPool(t *testing.T) {
+	listener := newEchoListener(t)
+	defer listener.Close()
+
+	cp := NewConnPool(&Config{
+		Network:     "tcp",
+		Address:     listener.Addr().String(),
+		DialTimeout: time.Second,
+		PoolSize:    1,
+	})
+	unitContext := context.TODO()
+
+	conn, connErr := cp.Get(unitContext)
+	assert.NoError(t, connErr)
+	assert.NotNil(t, conn)
+
+	_, writeErr := conn.Write([]byte("unit\n"))
+	assert.NoError(t, writeErr)
+
+	reply, readErr := bufio.NewReader(conn).ReadString('\n')
+	assert.NoError(t, readErr)
+	assert.Equal(t, "unit\n", reply)
+
+	cp.Put(conn)
+
+	reusedConn, reusedConnErr := cp.Get(unitContext)
+	assert.NoError(t, reusedConnErr)
+	assert.Same(t, conn, reusedConn, "expected that connection is reused")
+	cp.Put(reusedConn)
+
+	assert.NoError(t, cp.Close(unitContext))
+
+	_, writeErr = conn.Write([]byte("unit\n"))
+	assert.Error(t, writeErr, "expected that connection is closed")
+}
+
+func TestConnPoolWaitsForConnection(t *testing.T) {
+	listener := newEchoListener(t)
+	defer listener.Close()
+
+	cp := NewConnPool(&Config{Network: "tcp", Address: listener.Addr().String(), PoolSize: 1})
+	defer cp.Close(context.TODO())
+
+	conn, connErr := cp.Get(context.TODO())
+	assert.NoError(t, connErr)
+
+	unitContext, unitContextCancel := context.WithTimeout(context.TODO(), 10*time.Millisecond)
+	defer unitContextCancel()
+
+	_, connErr = cp.Get(unitContext)
+	assert.ErrorIs(t, connErr, context.DeadlineExceeded)
+
+	cp.Put(conn)
+}
+
+func TestConnPoolDialError(t *testing.T) {
+	listener := newEchoListener(t)
+	address := listener.Addr().String()
+	listener.Close()
+
+	cp := NewConnPool(&Config{Network: "tcp", Address: address, DialTimeout: time.Second, PoolSize: 1})
+
+	conn, connErr := cp.Get(context.TODO())
+	assert.ErrorIs(t, connErr, pool.ErrorResourceConstruction)
+	assert.Nil(t, conn)
+
+	// Failed connection must not hold place in pool.
+	_, connErr = cp.Get(context.TODO())
+	assert.Error(t, connErr)
+	assert.NotErrorIs(t, connErr, context.DeadlineExceeded)
+}
+
+func TestConnPoolDiscard(t *testing.T) {
+	listener := newEchoListener(t)
+	defer listener.Close()
+
+	cp := NewConnPool(&Config{Network: "tcp", Address: listener.Addr().String(), PoolSize: 1})
+	defer cp.Close(context.TODO())
+
+	conn, connErr := cp.Get(context.TODO())
+	assert.NoError(t, connErr)
+	assert.NoError(t, cp.Discard(conn))
+
+	_, writeErr := conn.Write([]byte("unit\n"))
+	assert.Error(t, writeErr, "expected that connection is closed")
+
+	unitContext, unitContextCancel := context.WithTimeout(context.TODO(), time.Second)
+	defer unitContextCancel()
+
+	newConn, newConnErr := cp.Get(unitContext)
+	assert.NoError(t, newConnErr, "expected that discarded connection frees place in pool")
+	assert.NotSame(t, conn, newConn, "expected that discarded connection is not reused")
+	cp.Put(newConn)
+}
+
+func TestConnPoolDefaultPoolSize(t *testing.T) {
+	listener := newEchoListener(t)
+	defer listener.Close()
+
+	cp := NewConnPool(&Config{Network: "tcp", Address: listener.Addr().String()})
+	defer cp.Close(context.TODO())
+
+	unitContext, unitContextCancel := context.WithTimeout(context.TODO(), time.Second)
+	defer unitContextCancel()
+
+	conn, connErr := cp.Get(unitContext)
+	assert.NoError(t, connErr, "expected that zero-value PoolSize is replaced by default")
+	cp.Put(conn)
+}
@@ -0,0 +1,95 @@
+package pool
+
+import "time"
+
+// minReaperInterval limits how often reaper checks idle resources when maxIdleTime is very short.
+const minReaperInterval = time.Millisecond
+
+// SetMaxIdleTime starts background reaper that deconstructs and removes from pool resources
+// that are idle longer than maxIdleTime, so resources created during spike of usage are not held forever.
+// Calling it again replaces previous setting, '0' or negative stops eviction.
+// Use Stop to terminate reaper when ResourcePoolManager is not needed anymore.
+func (rpm *ResourcePoolManager[T]) SetMaxIdleTime(maxIdleTime time.Duration) {
+	rpm.mu.Lock()
+	// Previous reaper is swapped under the lock, so concurrent calls can't lose track of any reaper.
+	reaperStop, reaperDone := rpm.reaperStop, rpm.reaperDone
+	rpm.reaperStop, rpm.reaperDone = nil, nil
+	if maxIdleTime > 0 {
+		rpm.reaperStop = make(chan struct{})
+		rpm.reaperDone = make(chan struct{})
+		go rpm.reapIdleResources(maxIdleTime, rpm.reaperStop, rpm.reaperDone)
+	}
+	rpm.mu.Unlock()
+
+	stopReaper(reaperStop, reaperDone)
+}
+
+// Stop terminates background reaper started by SetMaxIdleTime and waits until it's finished.
+// Resources are kept in pool, use CleanUp to destroy them.
+func (rpm *ResourcePoolManager[T]) Stop() {
+	rpm.mu.Lock()
+	reaperStop, reaperDone := rpm.reaperStop, rpm.reaperDone
+	rpm.reaperStop, rpm.reaperDone = nil, nil
+	rpm.mu.Unlock()
+
+	stopReaper(reaperStop, reaperDone)
+}
+
+// stopReaper terminates reaper if it's running and waits until it's finished.
+func stopReaper(reaperStop, reaperDone chan struct{}) {
+	if reaperStop == nil {
+		return
+	}
+
+	close(reaperStop)
+	<-reaperDone
+}
+
+func (rpm *ResourcePoolManager[T]) reapIdleResources(maxIdleTime time.Duration, stop <-chan struct{}, done chan<- struct{}) {
+	defer close(done)
+
+	ticker := time.NewTicker(max(maxIdleTime/2, minReaperInterval))
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			rpm.evictIdleResources(maxIdleTime)
+		}
+	}
+}
+
+// evictIdleResources destroys resources that are idle longer than maxIdleTime.
+func (rpm *ResourcePoolManager[T]) evictIdleResources(maxIdleTime time.Duration) {
+	isEvicted := false
+	rpm.pool.Range(func(key, value any) bool {
+		mr, ok := value.(*managedResource[T])
+		if !ok {
+			return false
+		}
+
+		mr.mu.Lock()
+		defer mr.mu.Unlock()
+
+		if mr.isAcquired || mr.idleSince.IsZero() || time.Since(mr.idleSince) <= maxIdleTime {
+			return true
+		}
+
+		// Resource could be detached or destroyed while waiting for the lock.
+		if current, isManaged := rpm.pool.Load(key); !isManaged || current != value {
+			return true
+		}
+
+		_ = rpm.destroyManagedResource(mr.resource) //nolint:errcheck // idle resource is not used by anyone, there is no one to report error to.
+		isEvicted = true
+
+		return true
+	})
+
+	// Place in pool is freed, so waiting acquisition can construct new resource.
+	if isEvicted {
+		rpm.notifyWaiters()
+	}
+}
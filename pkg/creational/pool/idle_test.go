@@ -0,0 +1,67 @@
+package pool
+
+import (
+	"context"
+	"runtime"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetMaxIdleTime(t *testing.T) {
+	factory := &stubResettingFactory{}
+	manager := NewResourcePoolManager[stubResource](2, 0, factory)
+	unitContext := context.TODO()
+
+	idleRes, ackErr := manager.AcquireResource(unitContext, false)
+	assert.NoError(t, ackErr)
+	busyRes, ackErr := manager.AcquireResource(unitContext, false)
+	assert.NoError(t, ackErr)
+	manager.ReleaseResource(idleRes)
+
+	manager.SetMaxIdleTime(10 * time.Millisecond)
+
+	assert.Eventually(t, func() bool {
+		return manager.Stats().Total == 1
+	}, time.Second, time.Millisecond, "expected that idle resource is evicted")
+	manager.Stop() // Wait until reaper is finished.
+	assert.Nil(t, idleRes.someExternalObject, "expected that evicted resource is deconstructed")
+	assert.NotNil(t, busyRes.someExternalObject, "expected that acquired resource is kept")
+
+	newRes, ackErr := manager.AcquireResource(unitContext, false)
+	assert.NoError(t, ackErr)
+	assert.NotSame(t, idleRes, newRes, "expected that fresh resource is constructed after eviction")
+}
+
+func TestStopEviction(t *testing.T) {
+	manager := New(1, func() *stubResource { return new(stubResource) }, WithMaxIdleTime[stubResource](time.Millisecond))
+	manager.Stop()
+	manager.Stop() // No panic when already stopped.
+
+	warmed, warmUpErr := manager.WarmUp(context.TODO(), 1)
+	assert.NoError(t, warmUpErr)
+	assert.Equal(t, 1, warmed)
+
+	time.Sleep(10 * time.Millisecond)
+	assert.Equal(t, 1, manager.Stats().Total, "expected that resource is not evicted after Stop")
+}
+
+func TestSetMaxIdleTimeConcurrently(t *testing.T) {
+	goroutinesBefore := runtime.NumGoroutine()
+	manager := NewResourcePoolManager[stubResource](2, 0, new(stubFactory))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			manager.SetMaxIdleTime(time.Hour)
+		}()
+	}
+	wg.Wait()
+	manager.Stop()
+
+	assert.True(t, waitForGoroutines(goroutinesBefore, time.Second), "expected that every reaper is stopped")
+}
@@ -0,0 +1,73 @@
+package pool
+
+import (
+	"math/bits"
+	"sync/atomic"
+	"time"
+)
+
+// latencyBucketsCount amount of buckets in latencyHistogram,
+// each bucket upper bound is power of 2 in microseconds, so last one is over 35 minutes.
+const latencyBucketsCount = 32
+
+type (
+	// LatencyStats of acquiring resources in ResourcePoolManager,
+	// measured from the call of AcquireResource until resource is obtained, including waiting on retry.
+	// Percentiles are estimations, they are upper bound of the histogram bucket where percentile is found.
+	LatencyStats struct {
+		Count uint64
+		P50   time.Duration
+		P95   time.Duration
+		P99   time.Duration
+	}
+
+	// latencyHistogram is lightweight and thread safe histogram with exponential buckets.
+	latencyHistogram struct {
+		buckets [latencyBucketsCount]atomic.Uint64
+	}
+)
+
+func (h *latencyHistogram) record(d time.Duration) {
+	idx := 0
+	if d > 0 {
+		idx = bits.Len64(uint64(d.Microseconds()))
+	}
+	if idx >= latencyBucketsCount {
+		idx = latencyBucketsCount - 1
+	}
+
+	h.buckets[idx].Add(1)
+}
+
+func (h *latencyHistogram) stats() LatencyStats {
+	var counts [latencyBucketsCount]uint64
+	var total uint64
+	for i := range h.buckets {
+		counts[i] = h.buckets[i].Load()
+		total += counts[i]
+	}
+
+	return LatencyStats{
+		Count: total,
+		P50:   percentile(&counts, total, 50),
+		P95:   percentile(&counts, total, 95),
+		P99:   percentile(&counts, total, 99),
+	}
+}
+
+func percentile(counts *[latencyBucketsCount]uint64, total, p uint64) time.Duration {
+	if total == 0 {
+		return 0
+	}
+
+	rank := (total*p + 99) / 100
+	var cumulative uint64
+	for i, c := range counts {
+		cumulative += c
+		if cumulative >= rank {
+			return time.Duration(uint64(1)<<i) * time.Microsecond
+		}
+	}
+
+	return time.Duration(uint64(1)<<(latencyBucketsCount-1)) * time.Microsecond
+}
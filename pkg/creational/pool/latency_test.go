@@ -0,0 +1,65 @@
+package pool
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLatencyStats(t *testing.T) {
+	manager := NewResourcePoolManager[stubResource](2, 0, new(stubFactory))
+	manager.SetRetryOnResourceDelay(time.Millisecond)
+
+	assert.Equal(t, LatencyStats{}, manager.LatencyStats(), "expected empty stats before any acquisition")
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			err := manager.AcquireAndReleaseResource(context.TODO(), func(_ *stubResource) error {
+				time.Sleep(time.Millisecond)
+				return nil
+			})
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	// Failed acquisition must not be counted.
+	canceledCtx, cancel := context.WithCancel(context.TODO())
+	cancel()
+	_, ackErr := manager.AcquireResource(canceledCtx, false)
+	assert.Error(t, ackErr)
+
+	stats := manager.LatencyStats()
+	assert.Equal(t, uint64(10), stats.Count)
+	assert.True(t, stats.P50 > 0)
+	assert.True(t, stats.P50 <= stats.P95)
+	assert.True(t, stats.P95 <= stats.P99)
+	assert.True(t, stats.P99 >= time.Millisecond, "expected that waiting on taken resources is measured")
+}
+
+func TestLatencyHistogramPercentiles(t *testing.T) {
+	var h latencyHistogram
+	for i := 0; i < 90; i++ {
+		h.record(3 * time.Microsecond)
+	}
+	for i := 0; i < 9; i++ {
+		h.record(100 * time.Microsecond)
+	}
+	h.record(time.Hour)
+
+	stats := h.stats()
+	assert.Equal(t, uint64(100), stats.Count)
+	assert.Equal(t, 4*time.Microsecond, stats.P50)
+	assert.Equal(t, 128*time.Microsecond, stats.P95)
+	assert.Equal(t, 128*time.Microsecond, stats.P99)
+
+	h.record(time.Hour)
+	assert.Equal(t, time.Duration(1<<(latencyBucketsCount-1))*time.Microsecond, h.stats().P99)
+}
@@ -0,0 +1,119 @@
+package pool
+
+import (
+	"math"
+	"time"
+)
+
+type (
+	// PoolOption sets of configurations for ResourcePoolManager created by New.
+	PoolOption[T Resource] func(o *poolOptions[T])
+
+	// poolOptions collected by PoolOption.
+	poolOptions[T Resource] struct {
+		resourceUsageLimit uint8
+		maxIdleTime        time.Duration
+		factory            funcFactory[T]
+	}
+
+	// funcFactory is ResourceBuilder, ResourceResetter, ResourceValidator and ResourceHealthChecker made of functions.
+	funcFactory[T Resource] struct {
+		construct     func() *T
+		deconstruct   func(*T)
+		resetResource func(*T)
+		validate      func(*T) error
+		healthCheck   func(*T) bool
+	}
+)
+
+// WithUsageLimit sets how many times each resource can be acquired before deconstruction, '0' is unlimited (default).
+func WithUsageLimit[T Resource](resourceUsageLimit uint8) PoolOption[T] {
+	return func(o *poolOptions[T]) { o.resourceUsageLimit = resourceUsageLimit }
+}
+
+// WithDeconstruction sets function that gracefully destroys resource when ResourcePoolManager removes it.
+func WithDeconstruction[T Resource](deconstruct func(*T)) PoolOption[T] {
+	return func(o *poolOptions[T]) { o.factory.deconstruct = deconstruct }
+}
+
+// WithReset sets function that cleans resource state when it's released and will be reused, see ResourceResetter.
+func WithReset[T Resource](reset func(*T)) PoolOption[T] {
+	return func(o *poolOptions[T]) { o.factory.resetResource = reset }
+}
+
+// WithValidation sets function that checks resource created on WarmUp, see ResourceValidator.
+func WithValidation[T Resource](validate func(*T) error) PoolOption[T] {
+	return func(o *poolOptions[T]) { o.factory.validate = validate }
+}
+
+// WithHealthCheck sets function that checks idle resource before it's acquired, see ResourceHealthChecker.
+func WithHealthCheck[T Resource](healthCheck func(*T) bool) PoolOption[T] {
+	return func(o *poolOptions[T]) { o.factory.healthCheck = healthCheck }
+}
+
+// WithMaxIdleTime sets how long resource can stay idle before it's evicted, see ResourcePoolManager.SetMaxIdleTime.
+func WithMaxIdleTime[T Resource](maxIdleTime time.Duration) PoolOption[T] {
+	return func(o *poolOptions[T]) { o.maxIdleTime = maxIdleTime }
+}
+
+// New is a constructor of ResourcePoolManager alternative to NewResourcePoolManager
+// that doesn't require to implement ResourceBuilder, resources are created by create function
+// and the rest of behavior is configured by PoolOption.
+// size is the maximum size of the pool, negative is the same as '0' and 255 or more means that size is not limited.
+func New[T Resource](size int, create func() *T, opts ...PoolOption[T]) *ResourcePoolManager[T] {
+	o := &poolOptions[T]{factory: funcFactory[T]{construct: create}}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	poolSize := uint8(math.MaxUint8)
+	if size < 0 {
+		poolSize = 0
+	} else if size < math.MaxUint8 {
+		poolSize = uint8(size)
+	}
+
+	rpm := NewResourcePoolManager[T](poolSize, o.resourceUsageLimit, &o.factory)
+	if o.maxIdleTime > 0 {
+		rpm.SetMaxIdleTime(o.maxIdleTime)
+	}
+
+	return rpm
+}
+
+// Construct creates resource with create function given to New.
+func (f *funcFactory[T]) Construct() *T {
+	return f.construct()
+}
+
+// Deconstruction destroys resource with function given to WithDeconstruction if any.
+func (f *funcFactory[T]) Deconstruction(r *T) {
+	if f.deconstruct != nil {
+		f.deconstruct(r)
+	}
+}
+
+// Reset cleans resource with function given to WithReset if any.
+func (f *funcFactory[T]) Reset(r *T) {
+	if f.resetResource != nil {
+		f.resetResource(r)
+	}
+}
+
+// Validate checks resource with function given to WithValidation if any.
+func (f *funcFactory[T]) Validate(r *T) error {
+	if f.validate == nil {
+		return nil
+	}
+
+	return f.validate(r)
+}
+
+// HealthCheck checks resource with function given to WithHealthCheck if any.
+func (f *funcFactory[T]) HealthCheck(r *T) bool {
+	if f.healthCheck == nil {
+		return true
+	}
+
+	return f.healthCheck(r)
+}
@@ -0,0 +1,83 @@
+package pool
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewWithOptions(t *testing.T) {
+	constructed, reset, deconstructed := 0, 0, 0
+
+	manager := New(1,
+		func() *stubResource {
+			constructed++
+			return &stubResource{SomeValue: "NewOne"}
+		},
+		WithUsageLimit[stubResource](2),
+		WithReset(func(r *stubResource) {
+			reset++
+			r.SomeWork = false
+		}),
+		WithDeconstruction(func(r *stubResource) {
+			deconstructed++
+			r.SomeValue = ""
+		}),
+	)
+	unitContext := context.TODO()
+
+	assert.Equal(t, uint8(1), manager.maxPoolSize)
+	assert.Equal(t, uint8(2), manager.GetResourceUsageLimit())
+
+	firstRes, ackErr := manager.AcquireResource(unitContext, false)
+	assert.NoError(t, ackErr)
+	firstRes.SomeWork = true
+	manager.ReleaseResource(firstRes)
+	assert.False(t, firstRes.SomeWork, "expected to be reset on release")
+
+	secondRes, ackErr := manager.AcquireResource(unitContext, false)
+	assert.NoError(t, ackErr)
+	assert.Same(t, firstRes, secondRes)
+	manager.ReleaseResource(secondRes)
+
+	assert.Equal(t, 1, constructed)
+	assert.Equal(t, 1, reset)
+	assert.Equal(t, 1, deconstructed, "expected to be deconstructed when usage limit is reached")
+	assert.Equal(t, "", secondRes.SomeValue)
+}
+
+func TestNewWithoutOptions(t *testing.T) {
+	manager := New(-1, func() *stubResource { return new(stubResource) })
+	assert.Equal(t, uint8(0), manager.maxPoolSize)
+
+	_, ackErr := manager.AcquireResource(context.TODO(), false)
+	assert.ErrorIs(t, ackErr, ErrorPoolLimitReached)
+
+	manager = New(1000, func() *stubResource { return new(stubResource) })
+	assert.Equal(t, ^uint8(0), manager.maxPoolSize, "expected that size is not limited")
+
+	r, ackErr := manager.AcquireResource(context.TODO(), false)
+	assert.NoError(t, ackErr)
+	manager.ReleaseResource(r) // No panic without reset and deconstruction.
+}
+
+func Example_newWithOptions() {
+	// New creates pool without need to implement ResourceBuilder, only function to create resource is required.
+	bufferPool := New(10,
+		func() *bytes.Buffer { return new(bytes.Buffer) },
+		// Buffer is cleaned when returned back to pool, so next user gets empty one.
+		WithReset(func(b *bytes.Buffer) { b.Reset() }),
+	)
+
+	_ = bufferPool.AcquireAndReleaseResource(context.TODO(), func(b *bytes.Buffer) error {
+		b.WriteString("Hey ho, let's go")
+		fmt.Println(b.String())
+
+		return nil
+	})
+
+	// Output: Hey ho, let's go
+}
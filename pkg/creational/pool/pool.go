@@ -15,6 +15,7 @@ package pool
 import (
 	"context"
 	"errors"
+	"fmt"
 	"sync"
 	"time"
 )
@@ -22,6 +23,10 @@ import (
 var (
 	// ErrorPoolLimitReached thrown when ResourcePoolManager current pool size is reached to Maximum allowed size.
 	ErrorPoolLimitReached = errors.New("resource - pool limit reached")
+	// ErrorResourceValidation thrown when resource created by ResourceBuilder is not valid, see ResourceValidator.
+	ErrorResourceValidation = errors.New("resource - validation failed")
+	// ErrorResourceConstruction thrown when ResourceBuilderE failed to construct new resource.
+	ErrorResourceConstruction = errors.New("resource - construction failed")
 	// ErrorContextCanceled thrown when client (passed) context is canceled and operation must be canceled.
 	ErrorContextCanceled = context.DeadlineExceeded
 )
@@ -30,6 +35,8 @@ const defaultRetryOnResourceDelay = time.Second
 
 type (
 	// Resource which will be managed by the ResourcePoolManager.
+	// Resources are always handled by pointer *T since pool tracks them by address,
+	// so small value types must be wrapped, see BufferPool as an example.
 	Resource interface {
 		any
 	}
@@ -42,6 +49,55 @@ type (
 		Deconstruction(*T)
 	}
 
+	// ResourceBuilderE is ResourceBuilder that can report failure of construction, for example when dial fails.
+	// Use AdaptBuilderE to pass it to NewResourcePoolManager, or implement it along with ResourceBuilder,
+	// then ConstructE is used instead of Construct.
+	ResourceBuilderE[T Resource] interface {
+		// ConstructE will be called to create new instance of Resource, error is returned from AcquireResource.
+		ConstructE() (*T, error)
+		// Deconstruction will be called when ResourcePool will need gracefully destroy/clean object.
+		Deconstruction(*T)
+	}
+
+	// ResourceBuilderContext is ResourceBuilderE that gets context of acquisition, for example to limit time of dialing.
+	// Use AdaptBuilderContext to pass it to NewResourcePoolManager, or implement it along with ResourceBuilder,
+	// then ConstructContext is used instead of Construct and ConstructE.
+	ResourceBuilderContext[T Resource] interface {
+		// ConstructContext will be called to create new instance of Resource, error is returned from AcquireResource.
+		ConstructContext(ctx context.Context) (*T, error)
+		// Deconstruction will be called when ResourcePool will need gracefully destroy/clean object.
+		Deconstruction(*T)
+	}
+
+	// ResourceResetter is an optional interface of ResourceBuilder to clean resource state between usages.
+	ResourceResetter[T Resource] interface {
+		// Reset will be called when resource is released back to ResourcePool and will be reused,
+		// unlike Deconstruction it must keep resource usable, for example clear buffer or rollback transaction.
+		Reset(*T)
+	}
+
+	// ResourceValidator is an optional interface of ResourceBuilder to check that created resource is usable,
+	// for example that connection to backend is established.
+	ResourceValidator[T Resource] interface {
+		// Validate will be called when resource is constructed on WarmUp, invalid resource is deconstructed.
+		Validate(*T) error
+	}
+
+	// ResourceHealthChecker is an optional interface of ResourceBuilder to check that idle resource is still usable
+	// before it's handed out, for example that connection didn't go stale while idle.
+	ResourceHealthChecker[T Resource] interface {
+		// HealthCheck will be called before idle resource is acquired, unhealthy resource is deconstructed
+		// and next idle resource is checked or new one is constructed instead.
+		HealthCheck(*T) bool
+	}
+
+	// ResourceDeconstructorE is an optional interface of ResourceBuilder to report failure of deconstruction,
+	// for example when connection could not be closed. If implemented it's used instead of Deconstruction.
+	ResourceDeconstructorE[T Resource] interface {
+		// DeconstructionE destroys resource same as Deconstruction, error is reported by CleanUp.
+		DeconstructionE(*T) error
+	}
+
 	// ResourcePool functionality.
 	ResourcePool[T Resource] interface {
 		// AcquireResource retrieves an available resource from the pool.
@@ -63,10 +119,15 @@ type (
 	// managedResource is a struct that represents a resource managed within the ResourcePoolManager.
 	// It contains a flags for resource being acquired and usage count along with the resource itself.
 	managedResource[T Resource] struct {
-		isAcquired bool
-		usageCount uint8
-		resource   *T
-		mu         sync.Mutex
+		isAcquired  bool
+		isSingleUse bool
+		usageCount  uint8
+		// idleSince is time when resource became idle, used to evict resources idle longer than maxIdleTime.
+		idleSince time.Time
+		// affinityKey is the latest key associated with resource by AcquireAffinity.
+		affinityKey string
+		resource    *T
+		mu          sync.Mutex
 	}
 
 	// resourceObtainer used to thread safely get/create resources.
@@ -82,9 +143,29 @@ type (
 		pool               sync.Map
 		maxPoolSize        uint8
 		resourceUsageLimit uint8
-		// retryOnResourceDelay used on resource manipulation (AcquireResource).
+		// retryOnResourceDelay is the longest time AcquireResource waits for resourceAvailable before next attempt.
 		retryOnResourceDelay time.Duration
-		mu                   sync.RWMutex
+		// resourceAvailable is closed and replaced when resource is returned or place in pool is freed, see notifyWaiters.
+		resourceAvailable   chan struct{}
+		resourceAvailableMu sync.Mutex
+		// reservedCount of places in pool for resources that are being constructed, guarded by reserveMu.
+		reservedCount int
+		reserveMu     sync.Mutex
+		// acquireLatency collects time spent in AcquireResource until resource is obtained.
+		acquireLatency latencyHistogram
+		// affinity holds association of key with resource acquired by AcquireAffinity.
+		affinity sync.Map
+		// saturation signals change of isSaturated, see Saturation.
+		saturation    chan struct{}
+		isSaturated   bool
+		acquiredCount int
+		// peakAcquiredCount is the highest acquiredCount, see Stats.
+		peakAcquiredCount int
+		saturationMu      sync.Mutex
+		// reaperStop terminates reaper of idle resources and reaperDone is closed when it's finished, see SetMaxIdleTime.
+		reaperStop chan struct{}
+		reaperDone chan struct{}
+		mu         sync.RWMutex
 	}
 )
 
@@ -102,50 +183,141 @@ func NewResourcePoolManager[T Resource](poolSize, resourceUsageLimit uint8, reso
 		resourceUsageLimit:   resourceUsageLimit,
 		maxPoolSize:          poolSize,
 		retryOnResourceDelay: defaultRetryOnResourceDelay,
+		resourceAvailable:    make(chan struct{}),
+		saturation:           make(chan struct{}, 1),
 	}
 }
 
 // AcquireResource retrieves an available resource from the pool.
 // ctx context.Context - controlling code flow, if `isNeedToRetryOnTaken` will be true
-// ResourcePoolManager will try to obtain Resource when it will be available recursively until context.Context will be canceled.
-// If there is no need to re-try, pass `isNeedToRetryOnTaken` as false.
+// ResourcePoolManager will wait until Resource is released or place in pool is freed and try to obtain it again,
+// until context.Context will be canceled. If there is no need to re-try, pass `isNeedToRetryOnTaken` as false.
+// Construction of new resource can be interrupted by context.Context only if factory implements ResourceBuilderContext,
+// otherwise it continues in background and constructed resource is put to pool as idle.
 func (rpm *ResourcePoolManager[T]) AcquireResource(ctx context.Context, isNeedToRetryOnTaken bool) (*T, error) {
-	if ctx.Err() != nil {
-		return nil, ctx.Err()
+	startedAt := time.Now()
+
+	r, rErr := rpm.acquireResource(ctx, isNeedToRetryOnTaken)
+	if rErr != nil {
+		return nil, rErr
 	}
 
-	obtainedResource := make(chan resourceObtainer[T], 1)
-	go rpm.getResource(obtainedResource)
+	rpm.acquireLatency.record(time.Since(startedAt))
 
-	select {
-	case <-ctx.Done():
-		return nil, ctx.Err()
-	case managedResource := <-obtainedResource:
-		if managedResource.error == nil {
-			return managedResource.resource, nil
-		} else if !isNeedToRetryOnTaken && managedResource.error != nil {
-			return nil, managedResource.error
+	return r, nil
+}
+
+// acquireResource tries to obtain resource and if needed waits for signal from notifyWaiters to retry.
+// retryOnResourceDelay limits waiting for the signal, so it's only a fallback.
+func (rpm *ResourcePoolManager[T]) acquireResource(ctx context.Context, isNeedToRetryOnTaken bool) (*T, error) {
+	for {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
 		}
 
-		// NOTE: Ignore pool limit since retry will handle resource acquiring in recursion.
-		if !errors.Is(managedResource.error, ErrorPoolLimitReached) {
-			return nil, managedResource.error
+		// NOTE: Taken before attempt, so resource released right after failed attempt is not missed.
+		resourceAvailable := rpm.waitForResource()
+
+		obtainedResource := make(chan resourceObtainer[T])
+		go rpm.obtainResource(ctx, obtainedResource)
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case managedResource := <-obtainedResource:
+			if managedResource.error == nil {
+				return managedResource.resource, nil
+			}
+
+			// NOTE: Only pool limit is worth waiting for, other errors are returned.
+			if !isNeedToRetryOnTaken || !errors.Is(managedResource.error, ErrorPoolLimitReached) {
+				return nil, managedResource.error
+			}
+		}
+
+		retryTimer := time.NewTimer(rpm.GetRetryOnResourceDelay())
+		select {
+		case <-ctx.Done():
+			retryTimer.Stop()
+			return nil, ctx.Err()
+		case <-resourceAvailable:
+			retryTimer.Stop()
+		case <-retryTimer.C:
 		}
 	}
+}
+
+// AcquireResourceOnce retrieves an available resource from the pool same as AcquireResource,
+// but resource is marked for single use, so ReleaseResource will deconstruct it regardless of resourceUsageLimit.
+// Useful when resource could be "poisoned" by risky operation and must not be reused.
+func (rpm *ResourcePoolManager[T]) AcquireResourceOnce(ctx context.Context, isNeedToRetryOnTaken bool) (*T, error) {
+	r, rErr := rpm.AcquireResource(ctx, isNeedToRetryOnTaken)
+	if rErr != nil {
+		return nil, rErr
+	}
+
+	value, ok := rpm.pool.Load(r)
+	if !ok {
+		return r, nil
+	}
+
+	managedResource, _ := value.(*managedResource[T]) //nolint:errcheck // value is already found by key, and it's strictly controlled how it's stored.
+	managedResource.mu.Lock()
+	managedResource.isSingleUse = true
+	managedResource.mu.Unlock()
+
+	return r, nil
+}
+
+// obtainResource hands over result of getResource to acquisition that is still waiting for it.
+// If acquisition is canceled meanwhile, for example construction took too long, obtained resource is released back to pool,
+// so neither goroutine nor resource is stranded.
+func (rpm *ResourcePoolManager[T]) obtainResource(ctx context.Context, resourceObtained chan<- resourceObtainer[T]) {
+	obtained := rpm.getResource(ctx)
 
 	select {
+	case resourceObtained <- obtained:
 	case <-ctx.Done():
-		return nil, ctx.Err()
-	case <-time.After(rpm.retryOnResourceDelay):
-		return rpm.AcquireResource(ctx, isNeedToRetryOnTaken)
+		if obtained.resource != nil {
+			rpm.ReleaseResource(obtained.resource)
+		}
 	}
 }
 
 // getResource if no resource is available, a new one is created using the provided factory method.
 // Acquisition of resources is thread-safe.
-func (rpm *ResourcePoolManager[T]) getResource(resourceObtained chan<- resourceObtainer[T]) {
-	var acqManagedResource *managedResource[T]
-	rpm.pool.Range(func(_, value any) bool {
+func (rpm *ResourcePoolManager[T]) getResource(ctx context.Context) resourceObtainer[T] {
+	if r, ok := rpm.acquireIdleResource(); ok {
+		return resourceObtainer[T]{resource: r}
+	}
+
+	if err := rpm.reserveResource(); err != nil {
+		return resourceObtainer[T]{error: err}
+	}
+
+	acqManagedResource, createErr := rpm.createManagedResource(ctx)
+	if createErr != nil {
+		rpm.cancelReservation()
+		rpm.notifyWaiters()
+
+		return resourceObtainer[T]{error: createErr}
+	}
+
+	acqManagedResource.usageCount++
+	acqManagedResource.isAcquired = true
+	rpm.pool.Store(acqManagedResource.resource, acqManagedResource)
+	rpm.cancelReservation()
+	rpm.trackAcquired(1)
+
+	return resourceObtainer[T]{resource: acqManagedResource.resource}
+}
+
+// acquireIdleResource marks the first found idle resource that is within usage limit and healthy as acquired,
+// unhealthy resources found on the way are deconstructed.
+func (rpm *ResourcePoolManager[T]) acquireIdleResource() (*T, bool) {
+	var acquired *T
+	isDiscarded := false
+	rpm.pool.Range(func(key, value any) bool {
 		mr, ok := value.(*managedResource[T])
 		if !ok {
 			return false
@@ -153,36 +325,57 @@ func (rpm *ResourcePoolManager[T]) getResource(resourceObtained chan<- resourceO
 
 		mr.mu.Lock()
 		defer mr.mu.Unlock()
-		if !mr.isAcquired {
-			// NOTE: If usageCount not 0 then it's set to be unlimited amount of usages.
-			if rpm.resourceUsageLimit == 0 || mr.usageCount < rpm.resourceUsageLimit {
-				acqManagedResource = mr
-			}
+
+		// NOTE: If usageCount not 0 then it's set to be unlimited amount of usages.
+		if mr.isAcquired || (rpm.resourceUsageLimit != 0 && mr.usageCount >= rpm.resourceUsageLimit) {
+			return true
 		}
 
-		return true
-	})
+		// Resource could be detached or destroyed while waiting for the lock.
+		if current, isManaged := rpm.pool.Load(key); !isManaged || current != value {
+			return true
+		}
 
-	if acqManagedResource == nil {
-		if err := rpm.verifyCurrentPoolSize(); err != nil {
-			resourceObtained <- resourceObtainer[T]{error: err}
-			return
+		if !rpm.isHealthy(mr.resource) {
+			_ = rpm.destroyManagedResource(mr.resource) //nolint:errcheck // resource is not usable anyway, there is no one to report error to.
+			isDiscarded = true
+
+			return true
 		}
-		acqManagedResource = rpm.createManagedResource()
+
+		mr.usageCount++
+		mr.isAcquired = true
+		acquired = mr.resource
+
+		return false
+	})
+
+	if isDiscarded {
+		rpm.notifyWaiters()
 	}
 
-	acqManagedResource.mu.Lock()
-	defer acqManagedResource.mu.Unlock()
+	if acquired == nil {
+		return nil, false
+	}
 
-	acqManagedResource.usageCount++
-	acqManagedResource.isAcquired = true
-	rpm.pool.Store(acqManagedResource.resource, acqManagedResource)
+	rpm.trackAcquired(1)
 
-	resourceObtained <- resourceObtainer[T]{resource: acqManagedResource.resource}
+	return acquired, true
+}
+
+// isHealthy checks resource with ResourceHealthChecker if factory implements it.
+func (rpm *ResourcePoolManager[T]) isHealthy(r *T) bool {
+	checker, isChecker := rpm.factory.(ResourceHealthChecker[T])
+	if !isChecker {
+		return true
+	}
+
+	return checker.HealthCheck(r)
 }
 
 // ReleaseResource releases a given resource back to the pool.
-// If a resource exceeds the usage limit it gets removed from the pool.
+// If a resource exceeds the usage limit or was acquired with AcquireResourceOnce it gets removed from the pool,
+// otherwise it's reset if factory implements ResourceResetter.
 // Releasing of resources is thread-safe.
 func (rpm *ResourcePoolManager[T]) ReleaseResource(releasedResource *T) {
 	value, ok := rpm.pool.Load(releasedResource)
@@ -192,54 +385,165 @@ func (rpm *ResourcePoolManager[T]) ReleaseResource(releasedResource *T) {
 
 	managedResource, _ := value.(*managedResource[T]) //nolint:errcheck // value is already found by key, and it's strictly controlled how it's stored.
 	managedResource.mu.Lock()
-	if rpm.resourceUsageLimit == 0 || managedResource.usageCount < rpm.resourceUsageLimit {
+	wasAcquired := managedResource.isAcquired
+	if !managedResource.isSingleUse && (rpm.resourceUsageLimit == 0 || managedResource.usageCount < rpm.resourceUsageLimit) {
+		if resetter, ok := rpm.factory.(ResourceResetter[T]); ok {
+			resetter.Reset(releasedResource)
+		}
 		managedResource.isAcquired = false
+		managedResource.idleSince = time.Now()
 		rpm.pool.Store(releasedResource, managedResource)
 	} else {
-		rpm.destroyManagedResource(releasedResource)
+		_ = rpm.destroyManagedResource(releasedResource) //nolint:errcheck // resource is not usable anyway, there is no one to report error to.
 	}
 
 	managedResource.mu.Unlock()
+
+	if wasAcquired {
+		rpm.trackAcquired(-1)
+	}
+	rpm.notifyWaiters()
 }
 
 // DetachResource will move out current resources from the management of ResourcePool.
 func (rpm *ResourcePoolManager[T]) DetachResource(resource *T) {
+	rpm.TryDetachResource(resource)
+}
+
+// TryDetachResource detaches resource same as DetachResource and reports if detach happened.
+// Returns false if resource is not managed by ResourcePool, for example it's foreign or already detached,
+// so caller can find out that detach was a no-op.
+func (rpm *ResourcePoolManager[T]) TryDetachResource(resource *T) bool {
 	r, ok := rpm.pool.Load(resource)
 	if !ok { // Not found, already not managed / deleted from pool
-		return
+		return false
 	}
 
 	managedResource, _ := r.(*managedResource[T]) //nolint:errcheck // value is already found by key, and it's strictly controlled how it's stored.
 	managedResource.mu.Lock()
 	defer managedResource.mu.Unlock()
 
-	rpm.pool.Delete(resource)
+	// Resource could be detached or destroyed by another goroutine while waiting for the lock.
+	if _, isLoaded := rpm.pool.LoadAndDelete(resource); !isLoaded {
+		return false
+	}
+	rpm.affinity.CompareAndDelete(managedResource.affinityKey, resource)
+	if managedResource.isAcquired {
+		rpm.trackAcquired(-1)
+	}
+	rpm.notifyWaiters()
+
+	return true
 }
 
-// CleanUpManagedResources all created resource in ResourcePool.
+// CleanUpManagedResources all created resource in ResourcePool, see CleanUp.
+// Returned error joins errors of deconstruction with ctx error.
 func (rpm *ResourcePoolManager[T]) CleanUpManagedResources(ctx context.Context) error {
-	if ctx.Err() != nil {
-		return ctx.Err()
-	}
+	summary, err := rpm.CleanUp(ctx)
 
-	for i := uint8(0); i < rpm.maxPoolSize; i++ {
-		var acqManagedResource *managedResource[T]
-		rpm.pool.Range(func(_, value any) bool {
-			mr, ok := value.(*managedResource[T])
-			if !ok {
-				return false
-			}
+	return errors.Join(append(summary.Errors, err)...)
+}
 
-			acqManagedResource = mr
+// RangeE calls fn sequentially for each idle resource managed by ResourcePool in no particular order,
+// acquired resources are skipped since they are in use by their holders.
+// Iteration stops at the first error returned by fn and the error is returned.
+// While fn is called the resource is marked as acquired, so it's not handed out to anyone else.
+// fn must not call any methods of ResourcePoolManager, for example ReleaseResource of the resource would break accounting.
+func (rpm *ResourcePoolManager[T]) RangeE(fn func(resource *T) error) error {
+	var fnErr error
+	rpm.pool.Range(func(key, value any) bool {
+		mr, ok := value.(*managedResource[T])
+		if !ok {
+			return true
+		}
 
+		if !rpm.holdIdleResource(key, mr) {
 			return true
-		})
-		if acqManagedResource != nil {
-			rpm.destroyManagedResource(acqManagedResource.resource)
 		}
+
+		fnErr = fn(mr.resource)
+		rpm.unholdResource(key, mr)
+
+		return fnErr == nil
+	})
+
+	return fnErr
+}
+
+// holdIdleResource marks idle resource as acquired without counting it as usage, see RangeE.
+func (rpm *ResourcePoolManager[T]) holdIdleResource(key any, mr *managedResource[T]) bool {
+	mr.mu.Lock()
+	defer mr.mu.Unlock()
+
+	// Resource could be acquired, detached or destroyed while waiting for the lock.
+	if current, isManaged := rpm.pool.Load(key); mr.isAcquired || !isManaged || current != mr {
+		return false
 	}
 
-	return nil
+	mr.isAcquired = true
+	rpm.trackAcquired(1)
+
+	return true
+}
+
+// unholdResource makes resource held by holdIdleResource idle again,
+// if it was destroyed or detached meanwhile then it's already not counted as acquired.
+func (rpm *ResourcePoolManager[T]) unholdResource(key any, mr *managedResource[T]) {
+	mr.mu.Lock()
+	if current, isManaged := rpm.pool.Load(key); isManaged && current == mr {
+		mr.isAcquired = false
+		rpm.trackAcquired(-1)
+	}
+	mr.mu.Unlock()
+
+	rpm.notifyWaiters()
+}
+
+// Saturation returns channel that signals when pool becomes saturated, so all resources are acquired
+// and pool reached maximum size, and when it stops being saturated since capacity frees up.
+// Signals are edge-triggered on each change and coalesced, only the latest change is kept if it's not received yet,
+// so after receiving a signal use IsSaturated to check the current state, for example to stop pulling work.
+// Pool without size limit is never saturated.
+func (rpm *ResourcePoolManager[T]) Saturation() <-chan struct{} {
+	return rpm.saturation
+}
+
+// IsSaturated checks if all resources are acquired and pool reached maximum size.
+func (rpm *ResourcePoolManager[T]) IsSaturated() bool {
+	rpm.saturationMu.Lock()
+	defer rpm.saturationMu.Unlock()
+
+	return rpm.isSaturatedUnsafe()
+}
+
+// trackAcquired changes count of acquired resources by delta and signals change of saturation.
+func (rpm *ResourcePoolManager[T]) trackAcquired(delta int) {
+	rpm.saturationMu.Lock()
+	defer rpm.saturationMu.Unlock()
+
+	rpm.acquiredCount += delta
+	rpm.peakAcquiredCount = max(rpm.peakAcquiredCount, rpm.acquiredCount)
+
+	isSaturated := rpm.isSaturatedUnsafe()
+	if isSaturated == rpm.isSaturated {
+		return
+	}
+	rpm.isSaturated = isSaturated
+
+	select {
+	case rpm.saturation <- struct{}{}:
+	default: // Signal is not received yet, it's enough to notify about change.
+	}
+}
+
+// isSaturatedUnsafe must be called under saturationMu.
+func (rpm *ResourcePoolManager[T]) isSaturatedUnsafe() bool {
+	// NOTE: Allow max size of type
+	if rpm.maxPoolSize == ^uint8(0) {
+		return false
+	}
+
+	return rpm.acquiredCount >= int(rpm.maxPoolSize)
 }
 
 // AcquireAndReleaseResource allows to execute action with needed Resource -> T.
@@ -253,19 +557,73 @@ func (rpm *ResourcePoolManager[T]) AcquireAndReleaseResource(ctx context.Context
 	return action(r)
 }
 
-func (rpm *ResourcePoolManager[T]) createManagedResource() *managedResource[T] {
-	return &managedResource[T]{
-		resource: rpm.factory.Construct(),
+// createManagedResource with the most capable construction that factory implements.
+func (rpm *ResourcePoolManager[T]) createManagedResource(ctx context.Context) (*managedResource[T], error) {
+	var r *T
+	var constructErr error
+
+	switch builder := rpm.factory.(type) {
+	case ResourceBuilderContext[T]:
+		r, constructErr = builder.ConstructContext(ctx)
+	case ResourceBuilderE[T]:
+		r, constructErr = builder.ConstructE()
+	default:
+		r = rpm.factory.Construct()
+	}
+
+	if constructErr != nil {
+		return nil, fmt.Errorf("%w: %w", ErrorResourceConstruction, constructErr)
+	}
+
+	return &managedResource[T]{resource: r}, nil
+}
+
+// destroyManagedResource removes resource and its affinity from pool and deconstructs it,
+// error is returned by ResourceDeconstructorE. Lock of managed resource must be held by caller.
+func (rpm *ResourcePoolManager[T]) destroyManagedResource(releasedResource *T) error {
+	if value, isLoaded := rpm.pool.LoadAndDelete(releasedResource); isLoaded {
+		mr, _ := value.(*managedResource[T]) //nolint:errcheck // value is already found by key, and it's strictly controlled how it's stored.
+		rpm.affinity.CompareAndDelete(mr.affinityKey, releasedResource)
+	}
+
+	return rpm.deconstruct(releasedResource)
+}
+
+// deconstruct resource with ResourceDeconstructorE if factory implements it, otherwise with Deconstruction.
+func (rpm *ResourcePoolManager[T]) deconstruct(r *T) error {
+	if deconstructor, ok := rpm.factory.(ResourceDeconstructorE[T]); ok {
+		return deconstructor.DeconstructionE(r)
 	}
+	rpm.factory.Deconstruction(r)
+
+	return nil
+}
+
+// reserveResource takes place in pool for resource that is going to be constructed,
+// so concurrent acquisitions can't create more resources than maximum size of pool.
+// Reservation must be canceled with cancelReservation after resource is stored in pool or construction failed.
+func (rpm *ResourcePoolManager[T]) reserveResource() error {
+	rpm.reserveMu.Lock()
+	defer rpm.reserveMu.Unlock()
+
+	if err := rpm.verifyCurrentPoolSize(); err != nil {
+		return err
+	}
+	rpm.reservedCount++
+
+	return nil
 }
 
-func (rpm *ResourcePoolManager[T]) destroyManagedResource(releasedResource *T) {
-	rpm.pool.Delete(releasedResource)
-	rpm.factory.Deconstruction(releasedResource)
+func (rpm *ResourcePoolManager[T]) cancelReservation() {
+	rpm.reserveMu.Lock()
+	defer rpm.reserveMu.Unlock()
+
+	rpm.reservedCount--
 }
 
+// verifyCurrentPoolSize must be called under reserveMu, reserved places are counted as taken.
 func (rpm *ResourcePoolManager[T]) verifyCurrentPoolSize() error {
-	var currentPoolSize uint8
+	currentPoolSize := rpm.reservedCount
 
 	// NOTE: Allow max size of type
 	if rpm.maxPoolSize == ^uint8(0) {
@@ -277,13 +635,30 @@ func (rpm *ResourcePoolManager[T]) verifyCurrentPoolSize() error {
 		return true
 	})
 
-	if currentPoolSize >= rpm.maxPoolSize {
+	if currentPoolSize >= int(rpm.maxPoolSize) {
 		return ErrorPoolLimitReached
 	}
 
 	return nil
 }
 
+// waitForResource returns channel that will be closed by notifyWaiters.
+func (rpm *ResourcePoolManager[T]) waitForResource() <-chan struct{} {
+	rpm.resourceAvailableMu.Lock()
+	defer rpm.resourceAvailableMu.Unlock()
+
+	return rpm.resourceAvailable
+}
+
+// notifyWaiters wakes up all acquisitions waiting for resource, so they try to obtain it again.
+func (rpm *ResourcePoolManager[T]) notifyWaiters() {
+	rpm.resourceAvailableMu.Lock()
+	defer rpm.resourceAvailableMu.Unlock()
+
+	close(rpm.resourceAvailable)
+	rpm.resourceAvailable = make(chan struct{})
+}
+
 // GetRetryOnResourceDelay returns the delay duration before the next retry attempt.
 func (rpm *ResourcePoolManager[T]) GetRetryOnResourceDelay() time.Duration {
 	rpm.mu.RLock()
@@ -299,3 +674,13 @@ func (rpm *ResourcePoolManager[T]) SetRetryOnResourceDelay(retryOnResourceDelay
 
 	rpm.retryOnResourceDelay = retryOnResourceDelay
 }
+
+// GetResourceUsageLimit returns how many times each resource can be acquired before deconstruction, '0' is unlimited.
+func (rpm *ResourcePoolManager[T]) GetResourceUsageLimit() uint8 {
+	return rpm.resourceUsageLimit
+}
+
+// LatencyStats returns count and percentiles of time spent to acquire resources, only successful acquisitions are counted.
+func (rpm *ResourcePoolManager[T]) LatencyStats() LatencyStats {
+	return rpm.acquireLatency.stats()
+}
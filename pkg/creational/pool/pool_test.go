@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"log"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -283,6 +284,81 @@ func TestModifyRetryOnResourceDelay(t *testing.T) {
 	assert.True(t, manager.GetRetryOnResourceDelay() == time.Nanosecond)
 }
 
+func TestAcquireResourceIsWokenUpOnRelease(t *testing.T) {
+	manager := NewResourcePoolManager[stubResource](1, 0, new(stubFactory))
+	manager.SetRetryOnResourceDelay(time.Hour)
+	unitContext := context.TODO()
+
+	firstRes, ackErr := manager.AcquireResource(unitContext, false)
+	assert.NoError(t, ackErr)
+
+	time.AfterFunc(10*time.Millisecond, func() { manager.ReleaseResource(firstRes) })
+
+	waitContext, cancel := context.WithTimeout(unitContext, time.Second)
+	defer cancel()
+
+	secondRes, ackErr := manager.AcquireResource(waitContext, true)
+	assert.NoError(t, ackErr, "expected that waiting acquisition is woken up by release instead of retry delay")
+	assert.Same(t, firstRes, secondRes)
+}
+
+func TestAcquireResourceConcurrentlyDoesNotExceedPoolSize(t *testing.T) {
+	var constructed atomic.Int32
+	manager := New(3, func() *stubResource {
+		constructed.Add(1)
+		time.Sleep(time.Millisecond) // Make concurrent construction more likely.
+
+		return new(stubResource)
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			assert.NoError(t, manager.AcquireAndReleaseResource(context.TODO(), func(_ *stubResource) error { return nil }))
+		}()
+	}
+	wg.Wait()
+
+	assert.LessOrEqual(t, constructed.Load(), int32(3))
+}
+
+func BenchmarkAcquireAndReleaseResource(b *testing.B) {
+	manager := NewResourcePoolManager[stubResource](1, 0, new(stubFactory))
+	unitContext := context.TODO()
+
+	for i := 0; i < b.N; i++ {
+		r, ackErr := manager.AcquireResource(unitContext, false)
+		if ackErr != nil {
+			b.Fatal("Benchmark failed with error:", ackErr)
+		}
+		manager.ReleaseResource(r)
+	}
+}
+
+func BenchmarkAcquireResourceContended(b *testing.B) {
+	manager := NewResourcePoolManager[stubResource](2, 0, new(stubFactory))
+	unitContext := context.TODO()
+
+	b.SetParallelism(4)
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			r, ackErr := manager.AcquireResource(unitContext, true)
+			if ackErr != nil {
+				b.Error("Benchmark failed with error:", ackErr)
+				return
+			}
+			manager.ReleaseResource(r)
+		}
+	})
+
+	stats := manager.LatencyStats()
+	b.ReportMetric(float64(stats.P50.Microseconds()), "p50-µs")
+	b.ReportMetric(float64(stats.P99.Microseconds()), "p99-µs")
+}
+
 func TestAcquireResourceToContextExpire(t *testing.T) {
 	manager := NewResourcePoolManager[stubResource](1, 1, new(stubFactory))
 	manager.retryOnResourceDelay = time.Nanosecond
@@ -361,6 +437,17 @@ func TestDetachResource(t *testing.T) {
 	assert.NotEqual(t, initRes.SomeValue, newRes.SomeValue)
 }
 
+func TestTryDetachResource(t *testing.T) {
+	manager := NewResourcePoolManager[stubResource](1, 0, new(stubFactory))
+
+	initRes, ackErr := manager.AcquireResource(context.TODO(), false)
+	assert.Nil(t, ackErr)
+
+	assert.True(t, manager.TryDetachResource(initRes))
+	assert.False(t, manager.TryDetachResource(initRes), "Expected no-op and no panic on already deleted")
+	assert.False(t, manager.TryDetachResource(&stubResource{}), "Expected no-op on foreign resource")
+}
+
 func TestResourceDeconstruction(t *testing.T) {
 	factory := &stubFactory{}
 	manager := NewResourcePoolManager[stubResource](1, 1, factory)
@@ -470,6 +557,190 @@ func TestCleanUpManagedResources(t *testing.T) {
 	}
 }
 
+func TestAcquireResourceOnce(t *testing.T) {
+	factory := &stubFactory{}
+	manager := NewResourcePoolManager[stubResource](1, 0, factory)
+	unitContext := context.TODO()
+
+	assert.Equal(t, uint8(0), manager.GetResourceUsageLimit())
+
+	onceRes, ackErr := manager.AcquireResourceOnce(unitContext, false)
+	assert.Nil(t, ackErr)
+	assert.NotNil(t, onceRes)
+	onceRes.SomeWork = true
+
+	manager.ReleaseResource(onceRes)
+
+	_, ok := manager.pool.Load(onceRes)
+	assert.False(t, ok, "expected that single use resource is deleted from pool on release")
+	assert.False(t, onceRes.SomeWork, "expected to be deconstructed on release")
+	assert.Nil(t, onceRes.someExternalObject, "expected to be deconstructed on release")
+
+	newRes, ackErr := manager.AcquireResource(unitContext, false)
+	assert.Nil(t, ackErr)
+	assert.NotSame(t, onceRes, newRes, "expected that new resource is constructed")
+
+	manager.ReleaseResource(newRes)
+	_, ok = manager.pool.Load(newRes)
+	assert.True(t, ok, "expected that regular resource is kept in pool")
+}
+
+func TestResetResourceOnRelease(t *testing.T) {
+	factory := &stubResettingFactory{}
+	manager := NewResourcePoolManager[stubResource](1, 2, factory)
+	unitContext := context.TODO()
+
+	initRes, ackErr := manager.AcquireResource(unitContext, false)
+	assert.Nil(t, ackErr)
+	initRes.SomeWork = true
+
+	manager.ReleaseResource(initRes)
+	assert.Equal(t, 1, factory.resetCount)
+	assert.False(t, initRes.SomeWork, "expected to be reset on release")
+	assert.Equal(t, "NewOne", initRes.SomeValue, "expected that reset keeps resource usable")
+	assert.NotNil(t, initRes.someExternalObject, "expected to be not deconstructed on release")
+
+	reusedRes, ackErr := manager.AcquireResource(unitContext, false)
+	assert.Nil(t, ackErr)
+	assert.Same(t, initRes, reusedRes, "expected that reset resource is reused")
+
+	// Usage limit is reached, so resource must be deconstructed instead of reset.
+	manager.ReleaseResource(reusedRes)
+	assert.Equal(t, 1, factory.resetCount)
+	assert.Nil(t, reusedRes.someExternalObject)
+}
+
+func TestAcquireResourceWithHealthCheck(t *testing.T) {
+	constructed, deconstructed := 0, 0
+	manager := New(3,
+		func() *stubResource {
+			constructed++
+			return &stubResource{SomeValue: "NewOne"}
+		},
+		WithHealthCheck(func(r *stubResource) bool { return r.SomeValue != "Stale" }),
+		WithDeconstruction(func(r *stubResource) { deconstructed++ }),
+	)
+	unitContext := context.TODO()
+
+	var resources []*stubResource
+	for i := 0; i < 3; i++ {
+		r, ackErr := manager.AcquireResource(unitContext, false)
+		assert.NoError(t, ackErr)
+		resources = append(resources, r)
+	}
+	resources[0].SomeValue = "Stale"
+	manager.ReleaseResource(resources[0])
+	manager.ReleaseResource(resources[1])
+
+	healthyRes, ackErr := manager.AcquireResource(unitContext, false)
+	assert.NoError(t, ackErr)
+	assert.Same(t, resources[1], healthyRes, "expected that healthy idle resource is acquired")
+
+	healthyRes.SomeValue = "Stale"
+	manager.ReleaseResource(healthyRes)
+
+	newRes, ackErr := manager.AcquireAffinity(unitContext, "tenant", false)
+	assert.NoError(t, ackErr)
+	assert.NotSame(t, resources[0], newRes, "expected that new resource is constructed instead of unhealthy one")
+	assert.NotSame(t, resources[1], newRes, "expected that new resource is constructed instead of unhealthy one")
+	assert.Equal(t, 4, constructed)
+	assert.Equal(t, 2, deconstructed, "expected that unhealthy idle resources are deconstructed")
+	assert.Equal(t, PoolStats{Total: 2, Acquired: 2, Peak: 3}, manager.Stats())
+
+	newRes.SomeValue = "Stale"
+	manager.ReleaseResource(newRes)
+
+	affinityRes, ackErr := manager.AcquireAffinity(unitContext, "tenant", false)
+	assert.NoError(t, ackErr)
+	assert.NotSame(t, newRes, affinityRes, "expected that unhealthy associated resource is not acquired")
+	assert.Equal(t, 3, deconstructed)
+	assert.Equal(t, PoolStats{Total: 2, Acquired: 2, Peak: 3}, manager.Stats())
+}
+
+func TestRangeE(t *testing.T) {
+	manager := NewResourcePoolManager[stubResource](3, 0, new(stubFactory))
+	unitContext := context.TODO()
+
+	var resources []*stubResource
+	for i := 0; i < 3; i++ {
+		r, ackErr := manager.AcquireResource(unitContext, false)
+		assert.Nil(t, ackErr)
+		resources = append(resources, r)
+	}
+	manager.ReleaseResource(resources[0])
+
+	visited := 0
+	assert.NoError(t, manager.RangeE(func(r *stubResource) error {
+		visited++
+		assert.Same(t, resources[0], r, "expected that acquired resources are skipped")
+		assert.Equal(t, 3, manager.Stats().Acquired, "expected that resource is held while fn is called")
+		return nil
+	}))
+	assert.Equal(t, 1, visited, "expected to visit only idle resources")
+	assert.Equal(t, PoolStats{Total: 3, Acquired: 2, Idle: 1, Peak: 3}, manager.Stats())
+
+	manager.ReleaseResource(resources[1])
+	manager.ReleaseResource(resources[2])
+
+	pingErr := errors.New("ping failed")
+	visited = 0
+	rangeErr := manager.RangeE(func(r *stubResource) error {
+		visited++
+		if visited == 2 {
+			return pingErr
+		}
+
+		return nil
+	})
+	assert.ErrorIs(t, rangeErr, pingErr)
+	assert.Equal(t, 2, visited, "expected that iteration stops on the first error")
+	assert.Equal(t, PoolStats{Total: 3, Idle: 3, Peak: 3}, manager.Stats())
+
+	r, ackErr := manager.AcquireResource(unitContext, false)
+	assert.Nil(t, ackErr)
+	assert.Contains(t, resources, r, "expected that resource is idle again after fn is called")
+}
+
+func TestSaturation(t *testing.T) {
+	manager := NewResourcePoolManager[stubResource](2, 0, new(stubFactory))
+	unitContext := context.TODO()
+
+	isSignaled := func() bool {
+		select {
+		case <-manager.Saturation():
+			return true
+		default:
+			return false
+		}
+	}
+
+	firstRes, ackErr := manager.AcquireResource(unitContext, false)
+	assert.Nil(t, ackErr)
+	assert.False(t, isSignaled())
+	assert.False(t, manager.IsSaturated())
+
+	secondRes, ackErr := manager.AcquireResource(unitContext, false)
+	assert.Nil(t, ackErr)
+	assert.True(t, isSignaled(), "expected signal when all resources are acquired")
+	assert.True(t, manager.IsSaturated())
+
+	manager.ReleaseResource(firstRes)
+	assert.True(t, isSignaled(), "expected signal when capacity frees up")
+	assert.False(t, manager.IsSaturated())
+
+	manager.DetachResource(secondRes)
+	assert.False(t, isSignaled(), "expected no signal when state is not changed")
+	assert.False(t, manager.IsSaturated())
+
+	unlimitedManager := NewResourcePoolManager[stubResource](^uint8(0), 0, new(stubFactory))
+	_, ackErr = unlimitedManager.AcquireResource(unitContext, false)
+	assert.Nil(t, ackErr)
+	assert.False(t, unlimitedManager.IsSaturated(), "expected that pool without size limit is never saturated")
+}
+
+// ResourcePoolManager must keep implementing ResourcePool.
+var _ ResourcePool[stubResource] = (*ResourcePoolManager[stubResource])(nil)
+
 type stubResource struct {
 	SomeWork           bool
 	SomeValue          string
@@ -488,6 +759,16 @@ func (m *stubFactory) Deconstruction(r *stubResource) {
 	r.someExternalObject = nil
 }
 
+type stubResettingFactory struct {
+	stubFactory
+	resetCount int
+}
+
+func (m *stubResettingFactory) Reset(r *stubResource) {
+	m.resetCount++
+	r.SomeWork = false
+}
+
 type stubRemoteConnectionFactory struct{}
 
 func (m *stubRemoteConnectionFactory) Construct() *stubRemoteConnectionFactory {
@@ -0,0 +1,34 @@
+package pool
+
+// PoolStats is a snapshot of resources in ResourcePoolManager.
+type PoolStats struct {
+	// Total count of resources managed by pool, both acquired and idle.
+	Total int
+	// Acquired count of resources that are currently in use.
+	Acquired int
+	// Idle count of resources that are waiting in pool to be acquired.
+	Idle int
+	// Peak is the highest count of resources that were in use at the same time.
+	Peak int
+}
+
+// Stats returns current count of managed, acquired and idle resources and peak of concurrent usage.
+// Counts are taken without blocking acquisition, so under concurrent use they are approximate.
+func (rpm *ResourcePoolManager[T]) Stats() PoolStats {
+	rpm.saturationMu.Lock()
+	acquired, peak := rpm.acquiredCount, rpm.peakAcquiredCount
+	rpm.saturationMu.Unlock()
+
+	total := 0
+	rpm.pool.Range(func(_, _ any) bool {
+		total++
+		return true
+	})
+
+	return PoolStats{
+		Total:    max(total, acquired),
+		Acquired: acquired,
+		Idle:     max(total-acquired, 0),
+		Peak:     peak,
+	}
+}
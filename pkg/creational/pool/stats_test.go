@@ -0,0 +1,55 @@
+package pool
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStats(t *testing.T) {
+	manager := NewResourcePoolManager[stubResource](3, 0, new(stubFactory))
+	unitContext := context.TODO()
+
+	assert.Equal(t, PoolStats{}, manager.Stats())
+
+	firstRes, ackErr := manager.AcquireResource(unitContext, false)
+	assert.NoError(t, ackErr)
+	secondRes, ackErr := manager.AcquireResource(unitContext, false)
+	assert.NoError(t, ackErr)
+	assert.Equal(t, PoolStats{Total: 2, Acquired: 2, Idle: 0, Peak: 2}, manager.Stats())
+
+	manager.ReleaseResource(firstRes)
+	assert.Equal(t, PoolStats{Total: 2, Acquired: 1, Idle: 1, Peak: 2}, manager.Stats())
+
+	manager.DetachResource(secondRes)
+	assert.Equal(t, PoolStats{Total: 1, Acquired: 0, Idle: 1, Peak: 2}, manager.Stats())
+}
+
+func TestStatsConcurrentUsage(t *testing.T) {
+	manager := NewResourcePoolManager[stubResource](3, 0, new(stubFactory))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			err := manager.AcquireAndReleaseResource(context.TODO(), func(_ *stubResource) error {
+				time.Sleep(time.Millisecond)
+				return nil
+			})
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	stats := manager.Stats()
+	assert.Equal(t, 0, stats.Acquired)
+	assert.Equal(t, stats.Total, stats.Idle)
+	assert.LessOrEqual(t, stats.Total, 3)
+	assert.GreaterOrEqual(t, stats.Peak, 1)
+	assert.LessOrEqual(t, stats.Peak, 3, "expected that peak is limited by pool size")
+}
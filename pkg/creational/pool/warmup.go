@@ -0,0 +1,80 @@
+package pool
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// WarmUp creates up to count idle resources in advance, so they are ready before the first AcquireResource,
+// and can be used as readiness check on startup of application.
+// If factory implements ResourceValidator each created resource is validated, invalid one is deconstructed
+// and not added to the pool. WarmUp stops when pool limit is reached or ctx is done.
+// Returns how many resources were added to the pool and error if not all of them could be warmed up,
+// errors of construction and validation are collected, validation errors are wrapped with ErrorResourceValidation.
+func (rpm *ResourcePoolManager[T]) WarmUp(ctx context.Context, count int) (int, error) {
+	var warmUpErrs []error
+	warmed := 0
+
+	for i := 0; i < count; i++ {
+		if ctx.Err() != nil {
+			return warmed, errors.Join(append(warmUpErrs, ctx.Err())...)
+		}
+
+		if sizeErr := rpm.reserveResource(); sizeErr != nil {
+			return warmed, errors.Join(append(warmUpErrs, sizeErr)...)
+		}
+
+		mr, createErr := rpm.createManagedResource(ctx)
+		if createErr != nil {
+			rpm.cancelReservation()
+			rpm.notifyWaiters()
+			warmUpErrs = append(warmUpErrs, createErr)
+
+			continue
+		}
+
+		if validationErr := rpm.validateResource(mr.resource); validationErr != nil {
+			rpm.cancelReservation()
+			rpm.notifyWaiters()
+			warmUpErrs = append(warmUpErrs, fmt.Errorf("%w: %w", ErrorResourceValidation, validationErr))
+			if deconstructErr := rpm.deconstruct(mr.resource); deconstructErr != nil {
+				warmUpErrs = append(warmUpErrs, deconstructErr)
+			}
+
+			continue
+		}
+
+		mr.idleSince = time.Now()
+		rpm.pool.Store(mr.resource, mr)
+		rpm.cancelReservation()
+		rpm.notifyWaiters()
+		warmed++
+	}
+
+	return warmed, errors.Join(warmUpErrs...)
+}
+
+// NewResourcePoolManagerWithWarmUp creates ResourcePoolManager same as NewResourcePoolManager
+// and eagerly constructs warmUpCount idle resources with WarmUp, so first acquisitions don't pay construction cost.
+// warmUpCount is limited by poolSize. ResourcePoolManager is returned even on error,
+// then it contains resources that were warmed up successfully and can be used or cleaned up.
+func NewResourcePoolManagerWithWarmUp[T Resource](
+	ctx context.Context, poolSize, resourceUsageLimit uint8, resourceFactory ResourceBuilder[T], warmUpCount int,
+) (*ResourcePoolManager[T], error) {
+	rpm := NewResourcePoolManager[T](poolSize, resourceUsageLimit, resourceFactory)
+	_, warmUpErr := rpm.WarmUp(ctx, min(warmUpCount, int(poolSize)))
+
+	return rpm, warmUpErr
+}
+
+// validateResource with ResourceValidator if factory implements it.
+func (rpm *ResourcePoolManager[T]) validateResource(r *T) error {
+	validator, isValidator := rpm.factory.(ResourceValidator[T])
+	if !isValidator {
+		return nil
+	}
+
+	return validator.Validate(r)
+}
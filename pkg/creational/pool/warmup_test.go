@@ -0,0 +1,137 @@
+package pool
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// stubValidatingFactory fails validation of every resource after validCount is reached.
+type stubValidatingFactory struct {
+	stubFactory
+	validCount    int
+	validateCount int
+}
+
+func (m *stubValidatingFactory) Validate(_ *stubResource) error {
+	m.validateCount++
+	if m.validateCount > m.validCount {
+		return errors.New("backend is not reachable")
+	}
+
+	return nil
+}
+
+func TestWarmUp(t *testing.T) {
+	manager := NewResourcePoolManager[stubResource](3, 0, new(stubFactory))
+	unitContext := context.TODO()
+
+	warmed, warmUpErr := manager.WarmUp(unitContext, 2)
+	assert.NoError(t, warmUpErr)
+	assert.Equal(t, 2, warmed)
+	assert.Equal(t, 0, manager.acquiredCount, "expected that warmed resources are idle")
+
+	warmed, warmUpErr = manager.WarmUp(unitContext, 2)
+	assert.ErrorIs(t, warmUpErr, ErrorPoolLimitReached)
+	assert.Equal(t, 1, warmed)
+
+	canceledCtx, cancel := context.WithCancel(unitContext)
+	cancel()
+	warmed, warmUpErr = NewResourcePoolManager[stubResource](3, 0, new(stubFactory)).WarmUp(canceledCtx, 1)
+	assert.ErrorIs(t, warmUpErr, context.Canceled)
+	assert.Equal(t, 0, warmed)
+}
+
+func TestWarmUpWithValidation(t *testing.T) {
+	factory := &stubValidatingFactory{validCount: 1}
+	manager := NewResourcePoolManager[stubResource](3, 0, factory)
+
+	warmed, warmUpErr := manager.WarmUp(context.TODO(), 3)
+	assert.ErrorIs(t, warmUpErr, ErrorResourceValidation)
+	assert.ErrorContains(t, warmUpErr, "backend is not reachable")
+	assert.Equal(t, 1, warmed)
+	assert.Equal(t, 3, factory.validateCount)
+
+	visited := 0
+	assert.NoError(t, manager.RangeE(func(r *stubResource) error {
+		visited++
+		return nil
+	}))
+	assert.Equal(t, 1, visited, "expected that invalid resources are not added to pool")
+}
+
+func TestWarmUpWithValidationOption(t *testing.T) {
+	manager := New(2,
+		func() *stubResource { return new(stubResource) },
+		WithValidation(func(*stubResource) error { return errors.New("backend is not reachable") }),
+	)
+
+	warmed, warmUpErr := manager.WarmUp(context.TODO(), 2)
+	assert.ErrorIs(t, warmUpErr, ErrorResourceValidation)
+	assert.Equal(t, 0, warmed)
+}
+
+// stubCountingFactory counts constructed resources and fails construction of failAt-th resource.
+type stubCountingFactory struct {
+	stubFactory
+	constructed int
+	failAt      int
+}
+
+func (m *stubCountingFactory) ConstructE() (*stubResource, error) {
+	m.constructed++
+	if m.constructed == m.failAt {
+		return nil, errors.New("connection refused")
+	}
+
+	return m.Construct(), nil
+}
+
+func TestNewResourcePoolManagerWithWarmUp(t *testing.T) {
+	factory := new(stubCountingFactory)
+	manager, warmUpErr := NewResourcePoolManagerWithWarmUp[stubResource](context.TODO(), 3, 0, factory, 10)
+	assert.NoError(t, warmUpErr)
+	assert.Equal(t, PoolStats{Total: 3, Idle: 3}, manager.Stats(), "expected that warm up is limited by pool size")
+
+	for i := 0; i < 3; i++ {
+		_, ackErr := manager.AcquireResource(context.TODO(), false)
+		assert.NoError(t, ackErr)
+	}
+	assert.Equal(t, 3, factory.constructed, "expected that acquisition reuses warmed resources")
+}
+
+func TestNewResourcePoolManagerWithWarmUpFailingConstruction(t *testing.T) {
+	factory := &stubCountingFactory{failAt: 2}
+	manager, warmUpErr := NewResourcePoolManagerWithWarmUp[stubResource](context.TODO(), 3, 0, factory, 3)
+	assert.ErrorIs(t, warmUpErr, ErrorResourceConstruction)
+	assert.ErrorContains(t, warmUpErr, "connection refused")
+	assert.Equal(t, PoolStats{Total: 2, Idle: 2}, manager.Stats(), "expected that warm up continues after failed construction")
+}
+
+// stubInvalidFailingDeconstructionFactory fails validation and deconstruction of every resource.
+type stubInvalidFailingDeconstructionFactory struct {
+	stubFactory
+	deconstructedCount int
+}
+
+func (m *stubInvalidFailingDeconstructionFactory) Validate(_ *stubResource) error {
+	return errors.New("backend is not reachable")
+}
+
+func (m *stubInvalidFailingDeconstructionFactory) DeconstructionE(_ *stubResource) error {
+	m.deconstructedCount++
+	return errors.New("unable to close connection")
+}
+
+func TestWarmUpDeconstructsInvalidResourceWithError(t *testing.T) {
+	factory := new(stubInvalidFailingDeconstructionFactory)
+	manager := NewResourcePoolManager[stubResource](2, 0, factory)
+
+	warmed, warmUpErr := manager.WarmUp(context.TODO(), 2)
+	assert.Equal(t, 0, warmed)
+	assert.ErrorIs(t, warmUpErr, ErrorResourceValidation)
+	assert.ErrorContains(t, warmUpErr, "unable to close connection")
+	assert.Equal(t, 2, factory.deconstructedCount, "expected that ResourceDeconstructorE is used for invalid resources")
+}
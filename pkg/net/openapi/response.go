@@ -3,10 +3,20 @@ package openapi
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"mime"
 	"net/http"
+	"strings"
 )
 
+// bodySnippetSize maximum amount of bytes from response body included in errors.
+const bodySnippetSize = 128
+
+// ErrUnexpectedContentType returned when successful response can't be decoded and its Content-Type is not JSON,
+// like HTML page of proxy.
+var ErrUnexpectedContentType = errors.New("unexpected response content type")
+
 // Response wraps the HTTP response from an API.
 type Response struct {
 	HTTPResponse     *http.Response // HTTPResponse holds the raw response from the HTTP request.
@@ -43,6 +53,8 @@ func ExtractErrorResponse(resp *Response) (errResponse map[string]any, parsingEr
 
 // ExtractResponse extracts the JSON payload from an Response into T if the HTTP status is successful or empty in cases like HTTP 204 No content.
 // Any error response placed as map, or an error if the extraction fails.
+// If successful response can't be decoded and has Content-Type header that is not JSON then error wraps ErrUnexpectedContentType.
+// Content-Type alone is not checked, since servers often write JSON without it and it's sniffed like text/plain.
 func ExtractResponse[T any](resp *Response) (expectedResponse *T, badRequestResponse map[string]any, parsingErr error) {
 	badRequestResponse, parsingErr = ExtractErrorResponse(resp)
 	if parsingErr != nil {
@@ -57,8 +69,32 @@ func ExtractResponse[T any](resp *Response) (expectedResponse *T, badRequestResp
 	}
 
 	if err := json.Unmarshal(*resp.HTTPResponseBody, &expectedResponse); err != nil {
+		if contentTypeErr := verifyJSONContentType(resp); contentTypeErr != nil {
+			return nil, nil, fmt.Errorf("failed to unmarshal successful response: %w: %w", contentTypeErr, err)
+		}
+
 		return nil, nil, fmt.Errorf("failed to unmarshal successful response: %w", err)
 	}
 
 	return expectedResponse, nil, nil
 }
+
+// verifyJSONContentType checks that Content-Type of Response is JSON (application/json or +json suffix), if it's set.
+func verifyJSONContentType(resp *Response) error {
+	contentType := resp.HTTPResponse.Header.Get("Content-Type")
+	if contentType == "" {
+		return nil
+	}
+
+	mediaType, _, parseErr := mime.ParseMediaType(contentType)
+	if parseErr == nil && (mediaType == "application/json" || strings.HasSuffix(mediaType, "+json")) {
+		return nil
+	}
+
+	snippet := *resp.HTTPResponseBody
+	if len(snippet) > bodySnippetSize {
+		snippet = snippet[:bodySnippetSize]
+	}
+
+	return fmt.Errorf("%w: %q, HTTP status: %d, body: %q", ErrUnexpectedContentType, contentType, resp.HTTPResponse.StatusCode, snippet)
+}
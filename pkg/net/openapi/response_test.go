@@ -239,6 +239,73 @@ func TestExtractBadRequestResponse(t *testing.T) {
 	assert.True(t, isContainDetails, "expected to be found `Detail` in response body")
 }
 
+func TestExtractResponseUnexpectedContentType(t *testing.T) {
+	type TestData struct {
+		Message string `json:"message"`
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, "<html><body>Bad Gateway</body></html>")
+	}))
+	defer server.Close()
+
+	resp, err := http.Get(server.URL)
+	if err != nil {
+		t.Fatalf("could not create request: %v", err)
+	}
+
+	respBody, bodyReadErr := io.ReadAll(resp.Body)
+	assert.NoError(t, bodyReadErr)
+
+	okResp, badResponse, err := ExtractResponse[TestData](&Response{HTTPResponse: resp, HTTPResponseBody: &respBody})
+	assert.Nil(t, okResp)
+	assert.Nil(t, badResponse)
+	assert.ErrorIs(t, err, ErrUnexpectedContentType)
+	assert.Contains(t, err.Error(), "text/html")
+	assert.Contains(t, err.Error(), "Bad Gateway")
+}
+
+func TestExtractResponseJSONContentTypes(t *testing.T) {
+	type TestData struct {
+		Message string `json:"message"`
+	}
+
+	for _, contentType := range []string{"application/json", "application/json; charset=utf-8", "application/problem+json", "text/plain; charset=utf-8", ""} {
+		t.Run(contentType, func(t *testing.T) {
+			header := http.Header{}
+			if contentType != "" {
+				header.Set("Content-Type", contentType)
+			}
+			respBody := []byte(`{"message":"Success"}`)
+
+			okResp, _, err := ExtractResponse[TestData](&Response{
+				HTTPResponse:     &http.Response{StatusCode: http.StatusOK, Header: header},
+				HTTPResponseBody: &respBody,
+			})
+			assert.NoError(t, err)
+			assert.Equal(t, "Success", okResp.Message)
+		})
+	}
+}
+
+func TestExtractResponseUnexpectedContentTypeSnippet(t *testing.T) {
+	type TestData struct {
+		Message string `json:"message"`
+	}
+
+	header := http.Header{}
+	header.Set("Content-Type", "text/plain")
+	respBody := []byte(strings.Repeat("a", bodySnippetSize) + "tail")
+
+	_, _, err := ExtractResponse[TestData](&Response{
+		HTTPResponse:     &http.Response{StatusCode: http.StatusOK, Header: header},
+		HTTPResponseBody: &respBody,
+	})
+	assert.ErrorIs(t, err, ErrUnexpectedContentType)
+	assert.NotContains(t, err.Error(), "tail", "expected only snippet of the body")
+}
+
 func Example_useCase() {
 	// To simplify response handling and avoid writing logic to parse default structure of OpenAPI might look like:
 	/*
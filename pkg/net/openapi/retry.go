@@ -0,0 +1,72 @@
+package openapi
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/coopnorge/member-lib/pkg/stringconv"
+)
+
+// RetryPolicy defines how ExtractWithRetry repeats requests on transient failures.
+type RetryPolicy struct {
+	// MaxRetries how many times request can be repeated after the first attempt.
+	MaxRetries uint8
+	// Delay between attempts, used when response has no valid Retry-After header.
+	Delay time.Duration
+	// MaxDelay optional cap for delay requested by Retry-After header, not limited if zero.
+	MaxDelay time.Duration
+}
+
+// ExtractWithRetry calls do and extracts its Response same as ExtractResponse,
+// but if response HTTP status is 5xx or 429 Too Many Requests it calls do again until RetryPolicy limit is reached.
+// Delay between attempts is taken from Retry-After header if present, otherwise RetryPolicy.Delay is used.
+// Error returned by do is not retried, when retries are exhausted the last response is extracted.
+func ExtractWithRetry[T any](ctx context.Context, do func(context.Context) (*Response, error), policy RetryPolicy) (*T, map[string]any, error) {
+	for attempt := uint8(0); ; attempt++ {
+		resp, doErr := do(ctx)
+		if doErr != nil {
+			return nil, nil, doErr
+		}
+
+		if attempt >= policy.MaxRetries || !isTransientResponse(resp) {
+			return ExtractResponse[T](resp)
+		}
+
+		// Timer is stopped explicitly, so long Retry-After doesn't keep it alive after ctx is done.
+		retryTimer := time.NewTimer(retryDelay(resp, policy))
+		select {
+		case <-ctx.Done():
+			retryTimer.Stop()
+			return nil, nil, ctx.Err()
+		case <-retryTimer.C:
+		}
+	}
+}
+
+// isTransientResponse checks if HTTP status of Response indicates that request could succeed later.
+func isTransientResponse(resp *Response) bool {
+	if resp == nil || resp.HTTPResponse == nil {
+		return false
+	}
+
+	return resp.HTTPResponse.StatusCode == http.StatusTooManyRequests || resp.HTTPResponse.StatusCode >= http.StatusInternalServerError
+}
+
+// retryDelay defines how long to wait before next attempt, Retry-After can be in seconds or HTTP date.
+func retryDelay(resp *Response, policy RetryPolicy) time.Duration {
+	delay := policy.Delay
+
+	retryAfter := resp.HTTPResponse.Header.Get("Retry-After")
+	if seconds, secondsErr := stringconv.ToWholeNumber[int32](retryAfter); secondsErr == nil {
+		delay = max(time.Duration(seconds)*time.Second, 0)
+	} else if date, dateErr := http.ParseTime(retryAfter); dateErr == nil {
+		delay = max(time.Until(date), 0)
+	}
+
+	if policy.MaxDelay > 0 && delay > policy.MaxDelay {
+		return policy.MaxDelay
+	}
+
+	return delay
+}
@@ -0,0 +1,152 @@
+package openapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newRetryTestDo(url string) func(context.Context) (*Response, error) {
+	return func(ctx context.Context) (*Response, error) {
+		req, reqErr := http.NewRequestWithContext(ctx, http.MethodGet, url, http.NoBody)
+		if reqErr != nil {
+			return nil, reqErr
+		}
+
+		resp, respErr := http.DefaultClient.Do(req)
+		if respErr != nil {
+			return nil, respErr
+		}
+		defer resp.Body.Close()
+
+		respBody, bodyReadErr := io.ReadAll(resp.Body)
+		if bodyReadErr != nil {
+			return nil, bodyReadErr
+		}
+
+		return &Response{HTTPResponse: resp, HTTPResponseBody: &respBody}, nil
+	}
+}
+
+func TestExtractWithRetry(t *testing.T) {
+	type TestData struct {
+		Message string `json:"message"`
+	}
+
+	var attempts atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if attempts.Add(1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			fmt.Fprint(w, `{"detail":"unavailable"}`)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(TestData{Message: "Success"})
+	}))
+	defer server.Close()
+
+	okResp, badResponse, err := ExtractWithRetry[TestData](context.TODO(), newRetryTestDo(server.URL), RetryPolicy{MaxRetries: 3, Delay: time.Millisecond})
+	assert.NoError(t, err)
+	assert.Nil(t, badResponse)
+	assert.Equal(t, "Success", okResp.Message)
+	assert.Equal(t, int32(2), attempts.Load())
+}
+
+func TestExtractWithRetryExhausted(t *testing.T) {
+	type TestData struct {
+		Message string `json:"message"`
+	}
+
+	var attempts atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts.Add(1)
+		w.Header().Set("Retry-After", "0")
+		w.WriteHeader(http.StatusTooManyRequests)
+		fmt.Fprint(w, `{"detail":"slow down"}`)
+	}))
+	defer server.Close()
+
+	okResp, badResponse, err := ExtractWithRetry[TestData](context.TODO(), newRetryTestDo(server.URL), RetryPolicy{MaxRetries: 2, Delay: time.Hour})
+	assert.NoError(t, err)
+	assert.Nil(t, okResp)
+	assert.Equal(t, "slow down", badResponse["detail"])
+	assert.Equal(t, int32(3), attempts.Load(), "expected first attempt and 2 retries, Retry-After must be used instead of delay")
+}
+
+func TestExtractWithRetryNotRetryingClientError(t *testing.T) {
+	type TestData struct {
+		Message string `json:"message"`
+	}
+
+	var attempts atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts.Add(1)
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"detail":"invalid input"}`)
+	}))
+	defer server.Close()
+
+	_, badResponse, err := ExtractWithRetry[TestData](context.TODO(), newRetryTestDo(server.URL), RetryPolicy{MaxRetries: 2})
+	assert.NoError(t, err)
+	assert.NotNil(t, badResponse)
+	assert.Equal(t, int32(1), attempts.Load())
+}
+
+func TestExtractWithRetryDoError(t *testing.T) {
+	type TestData struct {
+		Message string `json:"message"`
+	}
+
+	doErr := errors.New("unit test error")
+	_, _, err := ExtractWithRetry[TestData](context.TODO(), func(context.Context) (*Response, error) {
+		return nil, doErr
+	}, RetryPolicy{MaxRetries: 2})
+	assert.ErrorIs(t, err, doErr)
+}
+
+func TestExtractWithRetryContextCanceled(t *testing.T) {
+	type TestData struct {
+		Message string `json:"message"`
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	unitContext, unitContextCancel := context.WithTimeout(context.TODO(), 10*time.Millisecond)
+	defer unitContextCancel()
+
+	_, _, err := ExtractWithRetry[TestData](unitContext, newRetryTestDo(server.URL), RetryPolicy{MaxRetries: 2, Delay: time.Hour})
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestRetryDelay(t *testing.T) {
+	policy := RetryPolicy{Delay: time.Second, MaxDelay: time.Minute}
+	newResponse := func(retryAfter string) *Response {
+		header := http.Header{}
+		if retryAfter != "" {
+			header.Set("Retry-After", retryAfter)
+		}
+
+		return &Response{HTTPResponse: &http.Response{Header: header}}
+	}
+
+	assert.Equal(t, time.Second, retryDelay(newResponse(""), policy))
+	assert.Equal(t, 5*time.Second, retryDelay(newResponse("5"), policy))
+	assert.Equal(t, time.Minute, retryDelay(newResponse("3600"), policy))
+	assert.Equal(t, time.Duration(0), retryDelay(newResponse(time.Now().Add(-time.Hour).UTC().Format(http.TimeFormat)), policy))
+	assert.Equal(t, time.Second, retryDelay(newResponse("soon"), policy))
+	assert.Equal(t, time.Duration(0), retryDelay(newResponse("-5"), policy))
+}
@@ -17,6 +17,34 @@ type Token struct {
 	AccessToken string `json:"access_token"`
 	TokenType   string `json:"token_type"`
 	ExpiresIn   int64  `json:"expires_in,omitempty"`
+	// FetchedAt is time when Token was obtained by AccessToken, ExpiresIn is counted from it.
+	FetchedAt time.Time `json:"-"`
+}
+
+// ExpiresAt returns time when Token expires, zero time if authorization server didn't tell expiration
+// or FetchedAt is not set, for example when Token is created manually or decoded from cache.
+func (t Token) ExpiresAt() time.Time {
+	if t.ExpiresIn <= 0 || t.FetchedAt.IsZero() {
+		return time.Time{}
+	}
+
+	return t.FetchedAt.Add(time.Duration(t.ExpiresIn) * time.Second)
+}
+
+// Valid checks if Token can be used for at least margin duration, for example to refresh it before it expires.
+// Token without known expiration, see ExpiresAt, is valid as long as it has AccessToken,
+// so set FetchedAt of Token restored from cache to keep its expiration checked.
+func (t Token) Valid(margin time.Duration) bool {
+	if t.AccessToken == "" {
+		return false
+	}
+
+	expiresAt := t.ExpiresAt()
+	if expiresAt.IsZero() {
+		return true
+	}
+
+	return time.Now().Add(margin).Before(expiresAt)
 }
 
 // ClientConfig that will be applied to Client.
@@ -25,6 +53,9 @@ type ClientConfig struct {
 	AccessTokenURL string
 	// Transport that supports HTTP protocol.
 	Transport *http.Client
+	// RequestDecorator optional, allows to modify token request before it's sent,
+	// for example to add headers or query params required by authorization server.
+	RequestDecorator func(*http.Request) error
 }
 
 // ClientOAuth interface of Client.
@@ -37,14 +68,15 @@ type ClientOAuth interface {
 
 // AbstractClient allows get access token from IDP services.
 type AbstractClient struct {
-	acTokenURL string
-	transport  *http.Client
+	acTokenURL       string
+	transport        *http.Client
+	requestDecorator func(*http.Request) error
 	ClientOAuth
 }
 
 // NewClient that allows get access token.
 func NewClient(cfg *ClientConfig) *AbstractClient {
-	c := &AbstractClient{acTokenURL: cfg.AccessTokenURL}
+	c := &AbstractClient{acTokenURL: cfg.AccessTokenURL, requestDecorator: cfg.RequestDecorator}
 
 	if cfg.Transport != nil {
 		c.transport = cfg.Transport
@@ -73,6 +105,14 @@ func (c *AbstractClient) AccessToken() (Token, error) {
 	}
 	req.Header.Set("Content-Type", "application/json")
 
+	if c.requestDecorator != nil {
+		if decorateErr := c.requestDecorator(req); decorateErr != nil {
+			return Token{}, fmt.Errorf("error decorating request: %w", decorateErr)
+		}
+	}
+
+	// Taken before request is sent, so expiration is not overestimated by time of response.
+	fetchedAt := time.Now()
 	resp, sendReqErr := c.transport.Do(req)
 	if sendReqErr != nil {
 		return Token{}, fmt.Errorf("error sending request: %w", sendReqErr)
@@ -96,6 +136,7 @@ func (c *AbstractClient) AccessToken() (Token, error) {
 	if err := json.NewDecoder(resp.Body).Decode(&tokenResponse); err != nil {
 		return Token{}, fmt.Errorf("error decoding response: %w", err)
 	}
+	tokenResponse.FetchedAt = fetchedAt
 
 	return tokenResponse, nil
 }
@@ -2,10 +2,12 @@ package oauth
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 )
 
 // stubClientOAuth implements ClientOAuth interface for testing.
@@ -52,6 +54,41 @@ func TestAccessTokenSuccess(t *testing.T) {
 	if token.ExpiresIn != 3600 {
 		t.Errorf("Expected expires_in to be 3600, got '%d'", token.ExpiresIn)
 	}
+
+	if time.Until(token.ExpiresAt()) <= 59*time.Minute || time.Until(token.ExpiresAt()) > time.Hour {
+		t.Errorf("Expected token to expire in an hour, got '%s'", token.ExpiresAt())
+	}
+}
+
+func TestTokenValid(t *testing.T) {
+	token := Token{AccessToken: "test-token", ExpiresIn: 60, FetchedAt: time.Now()}
+
+	if !token.Valid(30 * time.Second) {
+		t.Error("Expected token to be valid outside of margin before expiry")
+	}
+
+	if token.Valid(time.Minute) {
+		t.Error("Expected token to be not valid within margin before expiry")
+	}
+
+	expiredToken := Token{AccessToken: "test-token", ExpiresIn: 60, FetchedAt: time.Now().Add(-2 * time.Minute)}
+	if expiredToken.Valid(0) {
+		t.Error("Expected expired token to be not valid")
+	}
+
+	withoutExpiration := Token{AccessToken: "test-token"}
+	if !withoutExpiration.ExpiresAt().IsZero() || !withoutExpiration.Valid(time.Hour) {
+		t.Error("Expected token without expiration to be valid")
+	}
+
+	withoutFetchedAt := Token{AccessToken: "test-token", ExpiresIn: 60}
+	if !withoutFetchedAt.ExpiresAt().IsZero() || !withoutFetchedAt.Valid(time.Minute) {
+		t.Error("Expected token without FetchedAt to have unknown expiration and be valid")
+	}
+
+	if (Token{}).Valid(0) {
+		t.Error("Expected empty token to be not valid")
+	}
 }
 
 func TestAccessTokenHTTPError(t *testing.T) {
@@ -93,6 +130,72 @@ func TestAccessTokenDecodeError(t *testing.T) {
 	}
 }
 
+func TestAccessTokenRequestDecorator(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Subscription-Key") != "test-key" || r.URL.Query().Get("tenant") != "test-tenant" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"access_token": "test-token", "token_type": "Bearer"}`))
+	}))
+	defer server.Close()
+
+	cfg := &ClientConfig{
+		AccessTokenURL: server.URL,
+		Transport:      server.Client(),
+		RequestDecorator: func(r *http.Request) error {
+			r.Header.Set("Subscription-Key", "test-key")
+			query := r.URL.Query()
+			query.Set("tenant", "test-tenant")
+			r.URL.RawQuery = query.Encode()
+
+			return nil
+		},
+	}
+	client := NewClient(cfg)
+	client.ClientOAuth = &stubClientOAuth{}
+
+	token, err := client.AccessToken()
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if token.AccessToken != "test-token" {
+		t.Errorf("Expected access token to be 'test-token', got '%s'", token.AccessToken)
+	}
+}
+
+func TestAccessTokenRequestDecoratorError(t *testing.T) {
+	isCalled := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		isCalled = true
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	decoratorErr := errors.New("missing subscription key")
+	cfg := &ClientConfig{
+		AccessTokenURL: server.URL,
+		Transport:      server.Client(),
+		RequestDecorator: func(*http.Request) error {
+			return decoratorErr
+		},
+	}
+	client := NewClient(cfg)
+	client.ClientOAuth = &stubClientOAuth{}
+
+	_, err := client.AccessToken()
+	if !errors.Is(err, decoratorErr) {
+		t.Fatalf("Expected decorator error, got %v", err)
+	}
+
+	if isCalled {
+		t.Error("Expected that request is not sent when decorator fails")
+	}
+}
+
 // exampleOAuthClient shows how could finally implementation could look like.
 // You could create separate struct per API where you might get Token.
 type exampleOAuthClient struct {
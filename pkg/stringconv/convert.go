@@ -0,0 +1,16 @@
+package stringconv
+
+import "fmt"
+
+// Convert safely converts whole number to another whole number type, for example narrows int64 to int32.
+// It returns an error if the value doesn't fit in range of To type, instead of silently overflowing.
+func Convert[From, To WholeNumber](v From) (To, error) {
+	result := To(v)
+
+	// Value fits if it's the same after round trip and sign is kept, sign check covers conversion between signedness.
+	if From(result) != v || (v < 0) != (result < 0) {
+		return 0, fmt.Errorf("failed to convert WholeNumber %d to %T, error: value is out of range", v, result)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,45 @@
+package stringconv
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConvert(t *testing.T) {
+	narrowed, err := Convert[int64, int32](math.MaxInt32)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(math.MaxInt32), narrowed)
+
+	narrowed, err = Convert[int64, int32](math.MinInt32)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(math.MinInt32), narrowed)
+
+	_, err = Convert[int64, int32](math.MaxInt32 + 1)
+	assert.Error(t, err)
+
+	_, err = Convert[int64, int32](math.MinInt32 - 1)
+	assert.Error(t, err)
+
+	unsigned, err := Convert[int, uint8](255)
+	assert.NoError(t, err)
+	assert.Equal(t, uint8(255), unsigned)
+
+	_, err = Convert[int, uint8](256)
+	assert.Error(t, err)
+
+	_, err = Convert[int8, uint64](-1)
+	assert.Error(t, err, "expected that negative value doesn't fit in unsigned type")
+
+	_, err = Convert[uint64, int64](math.MaxUint64)
+	assert.Error(t, err, "expected that large unsigned value doesn't turn negative")
+
+	signed, err := Convert[uint64, int64](math.MaxInt64)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(math.MaxInt64), signed)
+
+	widened, err := Convert[int16, int64](-300)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(-300), widened)
+}
@@ -0,0 +1,33 @@
+package stringconv
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// ToRatio converts percentage like "75%" or decimal like "0.75" to ratio 0.75.
+// If isRangeValidated is true then ratio must be between 0 and 1 inclusive, so "120%" gives an error.
+func ToRatio(str string, isRangeValidated bool) (float64, error) {
+	const errTmpl = "failed to convert string %q to ratio, error: %v"
+
+	number, isPercentage := strings.CutSuffix(strings.TrimSpace(str), "%")
+	ratio, parseErr := strconv.ParseFloat(strings.TrimSpace(number), 64)
+	if parseErr != nil {
+		return 0, fmt.Errorf(errTmpl, str, "value is not a number")
+	}
+	if math.IsNaN(ratio) || math.IsInf(ratio, 0) {
+		return 0, fmt.Errorf(errTmpl, str, "value is not a finite number")
+	}
+
+	if isPercentage {
+		ratio /= 100
+	}
+
+	if isRangeValidated && (ratio < 0 || ratio > 1) {
+		return 0, fmt.Errorf(errTmpl, str, "value is out of range from 0 to 1")
+	}
+
+	return ratio, nil
+}
@@ -0,0 +1,47 @@
+package stringconv
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToRatio(t *testing.T) {
+	testCases := []struct {
+		name             string
+		input            string
+		isRangeValidated bool
+		want             float64
+		wantError        bool
+	}{
+		{"Percentage", "75%", true, 0.75, false},
+		{"Decimal", "0.75", true, 0.75, false},
+		{"FullPercentage", "100%", true, 1, false},
+		{"ZeroPercentage", "0%", true, 0, false},
+		{"FractionalPercentage", "12.5%", true, 0.125, false},
+		{"WithSpaces", " 50 % ", true, 0.5, false},
+		{"OverRangeNotValidated", "150%", false, 1.5, false},
+		{"NegativeNotValidated", "-0.25", false, -0.25, false},
+		{"OverRangeValidated", "150%", true, 0, true},
+		{"NegativeValidated", "-1%", true, 0, true},
+		{"InvalidPercentage", "abc%", false, 0, true},
+		{"InvalidDecimal", "abc", false, 0, true},
+		{"OnlyPercentSign", "%", false, 0, true},
+		{"EmptyString", "", false, 0, true},
+		{"NotANumber", "NaN", false, 0, true},
+		{"Infinity", "Inf%", false, 0, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := ToRatio(tc.input, tc.isRangeValidated)
+			if tc.wantError {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.InDelta(t, tc.want, got, 1e-9)
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package stringconv
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"unicode"
+)
+
+// ScanWholeNumbers extracts all whole numbers from text, like "took 15ms after -3 retries" into [15, -3].
+// Number is a maximal run of digits, minus sign is a part of number only for signed types and
+// only when it's not preceded by a letter or digit, so "2024-10-15" gives [2024, 10, 15].
+// If number overflows T then error is returned, unless isSkipOverflow is true then such number is skipped.
+func ScanWholeNumbers[T WholeNumber](s string, isSkipOverflow bool) ([]T, error) {
+	isSigned := T(0)-1 < 0
+	bitSize := reflect.TypeOf(T(0)).Bits()
+	runes := []rune(s)
+	var numbers []T
+
+	for i := 0; i < len(runes); i++ {
+		if !isASCIIDigit(runes[i]) {
+			continue
+		}
+
+		start := i
+		if isSigned && start > 0 && runes[start-1] == '-' &&
+			(start == 1 || !(unicode.IsLetter(runes[start-2]) || isASCIIDigit(runes[start-2]))) {
+			start--
+		}
+		for i < len(runes) && isASCIIDigit(runes[i]) {
+			i++
+		}
+
+		number, numberErr := parseWholeNumber[T](string(runes[start:i]), isSigned, bitSize)
+		if numberErr != nil {
+			if isSkipOverflow {
+				continue
+			}
+
+			return nil, fmt.Errorf("failed to scan number %q at position %d: %w", string(runes[start:i]), start, numberErr)
+		}
+
+		numbers = append(numbers, number)
+	}
+
+	return numbers, nil
+}
+
+// parseWholeNumber parses number within exact range of T, so values that fit only in type of other signedness
+// are reported as out of range instead of wrapping around.
+func parseWholeNumber[T WholeNumber](str string, isSigned bool, bitSize int) (T, error) {
+	if isSigned {
+		number, err := strconv.ParseInt(str, 10, bitSize)
+		return T(number), err
+	}
+
+	number, err := strconv.ParseUint(str, 10, bitSize)
+
+	return T(number), err
+}
+
+// isASCIIDigit checks if rune is digit that can be parsed as whole number.
+func isASCIIDigit(r rune) bool {
+	return r >= '0' && r <= '9'
+}
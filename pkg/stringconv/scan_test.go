@@ -0,0 +1,64 @@
+package stringconv
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScanWholeNumbers(t *testing.T) {
+	testCases := []struct {
+		name  string
+		input string
+		want  []int64
+	}{
+		{"MixedText", "request 42 took 150ms after 3 retries", []int64{42, 150, 3}},
+		{"NegativeNumbers", "temperature -5, delta -12", []int64{-5, -12}},
+		{"Date", "2024-10-15", []int64{2024, 10, 15}},
+		{"Identifier", "user-7 id42abc", []int64{7, 42}},
+		{"NoNumbers", "no numbers here", nil},
+		{"EmptyString", "", nil},
+		{"OnlyNumber", "-9223372036854775808", []int64{-9223372036854775808}},
+		{"NonASCIIText", "æøå 12 ÆØÅ 34", []int64{12, 34}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := ScanWholeNumbers[int64](tc.input, false)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestScanWholeNumbersUnsigned(t *testing.T) {
+	got, err := ScanWholeNumbers[uint8]("from -5 to 255", false)
+	assert.NoError(t, err)
+	assert.Equal(t, []uint8{5, 255}, got)
+}
+
+func TestScanWholeNumbersOverflow(t *testing.T) {
+	input := "small 100, big 300, small again 7"
+
+	got, err := ScanWholeNumbers[int8](input, false)
+	assert.Error(t, err)
+	assert.Nil(t, got)
+
+	got, err = ScanWholeNumbers[int8](input, true)
+	assert.NoError(t, err)
+	assert.Equal(t, []int8{100, 7}, got)
+
+	for _, input := range []string{"value 128 and 5", "value 200 and 5", "value 255 and 5", "value -129 and 5"} {
+		got, err = ScanWholeNumbers[int8](input, false)
+		assert.Error(t, err, input)
+		assert.Nil(t, got, input)
+
+		got, err = ScanWholeNumbers[int8](input, true)
+		assert.NoError(t, err, input)
+		assert.Equal(t, []int8{5}, got, input)
+	}
+
+	gotUint, err := ScanWholeNumbers[uint8]("value 255 and 256", true)
+	assert.NoError(t, err)
+	assert.Equal(t, []uint8{255}, gotUint)
+}